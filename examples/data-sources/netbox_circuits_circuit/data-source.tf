@@ -0,0 +1,4 @@
+data "netbox_circuits_circuit" "circuit_test" {
+  provider_id = 1
+  cid         = "CID-0001"
+}
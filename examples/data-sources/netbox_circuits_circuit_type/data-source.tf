@@ -0,0 +1,3 @@
+data "netbox_circuits_circuit_type" "circuit_type_test" {
+  slug = "internet"
+}
@@ -0,0 +1,3 @@
+data "netbox_circuits_provider" "provider_test" {
+  slug = "provider1"
+}
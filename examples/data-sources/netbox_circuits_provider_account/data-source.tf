@@ -0,0 +1,4 @@
+data "netbox_circuits_provider_account" "provider_account_test" {
+  provider_id = 1
+  account     = "ACC-0001"
+}
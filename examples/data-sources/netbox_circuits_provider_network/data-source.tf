@@ -0,0 +1,4 @@
+data "netbox_circuits_provider_network" "provider_network_test" {
+  provider_id = 1
+  name        = "MPLS backbone"
+}
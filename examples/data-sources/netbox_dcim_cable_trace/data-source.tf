@@ -0,0 +1,4 @@
+data "netbox_dcim_cable_trace" "cable_trace_test" {
+  endpoint_type = "dcim.interface"
+  endpoint_id   = data.netbox_dcim_interface.interface_test.id
+}
@@ -0,0 +1,4 @@
+data "netbox_dcim_device" "device_test" {
+  name = "TestDevice"
+  site_id = 1
+}
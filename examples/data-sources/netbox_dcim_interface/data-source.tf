@@ -0,0 +1,4 @@
+data "netbox_dcim_interface" "interface_test" {
+  device_name = "device-test"
+  name        = "eth0"
+}
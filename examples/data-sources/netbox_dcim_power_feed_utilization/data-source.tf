@@ -0,0 +1,3 @@
+data "netbox_dcim_power_feed_utilization" "power_feed_utilization_test" {
+  power_feed_id = netbox_dcim_power_feed.power_feed_test.id
+}
@@ -0,0 +1,5 @@
+data "netbox_dcim_rack_elevation" "rack_elevation_test" {
+  rack_id  = 1
+  face     = "front"
+  u_height = 2
+}
@@ -0,0 +1,4 @@
+data "netbox_dcim_virtual_device_context" "virtual_device_context_test" {
+  device_id = 1
+  name      = "vdc1"
+}
@@ -0,0 +1,7 @@
+data "netbox_json_vpn_l2vpns_list" "test" {
+  limit = 0
+}
+
+output "example" {
+  value = jsondecode(data.netbox_json_vpn_l2vpns_list.test.json)
+}
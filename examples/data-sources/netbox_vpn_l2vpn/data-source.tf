@@ -0,0 +1,3 @@
+data "netbox_vpn_l2vpn" "l2vpn_test" {
+  name = "L2VPN"
+}
@@ -0,0 +1,4 @@
+data "netbox_vpn_l2vpn_termination" "l2vpn_termination_test" {
+  assigned_object_type = "ipam.vlan"
+  assigned_object_id   = 1
+}
@@ -0,0 +1,2 @@
+# Circuits can be imported by id
+terraform import netbox_circuits_circuit.circuit_test 1
@@ -0,0 +1,89 @@
+resource "netbox_circuits_circuit" "circuit_test" {
+  cid                 = "CID-0001"
+  provider_id         = netbox_circuits_provider.provider_test.id
+  provider_account_id = netbox_circuits_provider_account.provider_account_test.id
+  type_id             = netbox_circuits_circuit_type.circuit_type_test.id
+  status              = "active"
+  tenant_id           = netbox_tenancy_tenant.tenant_test.id
+  commit_rate         = 100000
+  install_date        = "2024-01-15"
+  termination_date    = "2027-01-15"
+  description         = "Internet uplink"
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = 1
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      1,
+      2
+    ])
+  }
+}
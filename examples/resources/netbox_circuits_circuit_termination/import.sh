@@ -0,0 +1,2 @@
+# Circuit terminations can be imported by id
+terraform import netbox_circuits_circuit_termination.circuit_termination_test 1
@@ -0,0 +1,2 @@
+# Circuit types can be imported by id
+terraform import netbox_circuits_circuit_type.circuit_type_test 1
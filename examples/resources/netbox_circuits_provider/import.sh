@@ -0,0 +1,2 @@
+# Providers can be imported by id
+terraform import netbox_circuits_provider.provider_test 1
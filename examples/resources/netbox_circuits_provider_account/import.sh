@@ -0,0 +1,2 @@
+# Provider accounts can be imported by id
+terraform import netbox_circuits_provider_account.provider_account_test 1
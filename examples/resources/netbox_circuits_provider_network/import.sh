@@ -0,0 +1,2 @@
+# Provider networks can be imported by id
+terraform import netbox_circuits_provider_network.provider_network_test 1
@@ -0,0 +1,2 @@
+# Cables can be imported by id
+terraform import netbox_dcim_cable.cable_test 1
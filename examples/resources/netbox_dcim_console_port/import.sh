@@ -0,0 +1,2 @@
+# Console ports can be imported by id
+terraform import netbox_dcim_console_port.console_port_test 1
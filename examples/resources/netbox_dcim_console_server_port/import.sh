@@ -0,0 +1,2 @@
+# Console server ports can be imported by id
+terraform import netbox_dcim_console_server_port.console_server_port_test 1
@@ -0,0 +1,2 @@
+# Devices can be imported by id
+terraform import netbox_dcim_device.device_test 1
@@ -0,0 +1,105 @@
+resource "netbox_dcim_device" "device_test" {
+  name = "Test device"
+  device_type_id = netbox_dcim_device_type.device_type_test.id
+  role_id = netbox_dcim_device_role.device_role_test.id
+  site_id = netbox_dcim_site.site_test.id
+  rack_id = netbox_dcim_rack.rack_test.id
+  position = 10
+  face = "front"
+  serial = "ABC123"
+  status = "planned"
+  airflow = "front-to-rear"
+  local_context_data = jsonencode({
+    hello = "world"
+  })
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = 1
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      1,
+      2
+    ])
+  }
+}
+
+resource "netbox_dcim_device" "device_placement_test" {
+  name = "Test device placement"
+  device_type_id = netbox_dcim_device_type.device_type_test.id
+  role_id = netbox_dcim_device_role.device_role_test.id
+  site_id = netbox_dcim_site.site_test.id
+
+  rack_placement {
+    rack_id = netbox_dcim_rack.rack_test.id
+    face = "front"
+    preference = "lowest"
+  }
+}
@@ -0,0 +1,2 @@
+# Device interfaces can be imported by the id of the device
+terraform import netbox_dcim_device_interfaces.device_interfaces_test 1
@@ -0,0 +1,19 @@
+resource "netbox_dcim_device_interfaces" "device_interfaces_test" {
+  device_id        = netbox_dcim_device.device_test.id
+  delete_unmanaged = true
+
+  interface {
+    name = "eth0"
+    type = "1000base-t"
+  }
+
+  interface {
+    name          = "eth1"
+    type          = "1000base-t"
+    description   = "Uplink"
+    mode          = "tagged"
+    mtu           = 9000
+    tagged_vlans  = [netbox_ipam_vlan.vlan_test.id]
+    untagged_vlan = netbox_ipam_vlan.vlan_test.id
+  }
+}
@@ -0,0 +1,2 @@
+# Device types can be imported by id
+terraform import netbox_dcim_device_type.device_type_test 1
@@ -0,0 +1,127 @@
+resource "netbox_dcim_device_type" "device_type_test" {
+  manufacturer_id = netbox_dcim_manufacturer.manufacturer_test.id
+  model = "Test device type"
+  slug = "test-device-type"
+  part_number = "TDT-1"
+  u_height = 1
+  is_full_depth = true
+  airflow = "front-to-rear"
+  weight = 7.5
+  weight_unit = "kg"
+  description = "Device type for test"
+
+  interface_template {
+    name = "eth0"
+    type = "1000base-t"
+    mgmt_only = true
+  }
+
+  interface_template {
+    name = "eth1"
+    type = "10gbase-x-sfpp"
+  }
+
+  console_port_template {
+    name = "console"
+    type = "rj-45"
+  }
+
+  power_port_template {
+    name = "psu0"
+    type = "iec-60320-c14"
+    maximum_draw = 300
+  }
+
+  rear_port_template {
+    name = "rear0"
+    type = "lc"
+  }
+
+  front_port_template {
+    name = "front0"
+    type = "lc"
+    rear_port = "rear0"
+  }
+
+  module_bay_template {
+    name = "slot1"
+    position = "1"
+  }
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = 1
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      1,
+      2
+    ])
+  }
+}
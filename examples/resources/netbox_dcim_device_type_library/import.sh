@@ -0,0 +1,2 @@
+# Device types can be imported by id
+terraform import netbox_dcim_device_type_library.device_type_library_test 1
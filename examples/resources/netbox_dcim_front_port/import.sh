@@ -0,0 +1,2 @@
+# Front ports can be imported by id
+terraform import netbox_dcim_front_port.front_port_test 1
@@ -0,0 +1,2 @@
+# Device interfaces can be imported by id
+terraform import netbox_dcim_interface.interface_test 1
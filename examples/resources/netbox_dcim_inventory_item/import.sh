@@ -0,0 +1,2 @@
+# Inventory items can be imported by id
+terraform import netbox_dcim_inventory_item.inventory_item_test 1
@@ -0,0 +1,2 @@
+# Inventory item roles can be imported by id
+terraform import netbox_dcim_inventory_item_role.inventory_item_role_test 1
@@ -0,0 +1,2 @@
+# Modules can be imported by id
+terraform import netbox_dcim_module.module_test 1
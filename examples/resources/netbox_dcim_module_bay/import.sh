@@ -0,0 +1,2 @@
+# Module bays can be imported by id
+terraform import netbox_dcim_module_bay.module_bay_test 1
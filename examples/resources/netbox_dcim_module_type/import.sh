@@ -0,0 +1,2 @@
+# Module types can be imported by id
+terraform import netbox_dcim_module_type.module_type_test 1
@@ -0,0 +1,2 @@
+# Power feeds can be imported by id
+terraform import netbox_dcim_power_feed.power_feed_test 1
@@ -0,0 +1,2 @@
+# Power outlets can be imported by id
+terraform import netbox_dcim_power_outlet.power_outlet_test 1
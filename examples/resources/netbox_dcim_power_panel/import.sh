@@ -0,0 +1,2 @@
+# Power panels can be imported by id
+terraform import netbox_dcim_power_panel.power_panel_test 1
@@ -0,0 +1,2 @@
+# Power ports can be imported by id
+terraform import netbox_dcim_power_port.power_port_test 1
@@ -0,0 +1,2 @@
+# Rack reservations can be imported by id
+terraform import netbox_dcim_rack_reservation.rack_reservation_test 1
@@ -0,0 +1,2 @@
+# Rear ports can be imported by id
+terraform import netbox_dcim_rear_port.rear_port_test 1
@@ -0,0 +1,2 @@
+# Virtual chassis can be imported by id
+terraform import netbox_dcim_virtual_chassis.virtual_chassis_test 1
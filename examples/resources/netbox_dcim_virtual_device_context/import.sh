@@ -0,0 +1,2 @@
+# Virtual device contexts can be imported by id
+terraform import netbox_dcim_virtual_device_context.virtual_device_context_test 1
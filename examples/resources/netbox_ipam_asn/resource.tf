@@ -82,3 +82,8 @@ resource "netbox_ipam_asn" "asn_test" {
     ])
   }
 }
+
+resource "netbox_ipam_asn" "dynamic_asn_test" {
+  asn_range_id = netbox_ipam_asn_range.asn_range_test.id
+  description = "Dynamic ASN created by terraform"
+}
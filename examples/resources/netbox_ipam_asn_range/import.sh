@@ -0,0 +1,2 @@
+# ASN ranges can be imported by id
+terraform import netbox_ipam_asn_range.test 1
@@ -0,0 +1,2 @@
+# FHRP groups can be imported by id
+terraform import netbox_ipam_fhrp_group.test 1
@@ -0,0 +1,2 @@
+# FHRP group assignments can be imported by id
+terraform import netbox_ipam_fhrp_group_assignment.test 1
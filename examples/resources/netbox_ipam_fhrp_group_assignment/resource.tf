@@ -0,0 +1,6 @@
+resource "netbox_ipam_fhrp_group_assignment" "test" {
+  fhrp_group_id  = netbox_ipam_fhrp_group.test.id
+  interface_type = "dcim.interface"
+  interface_id   = netbox_dcim_interface.test.id
+  priority       = 100
+}
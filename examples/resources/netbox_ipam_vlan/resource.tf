@@ -84,3 +84,9 @@ resource "netbox_ipam_vlan" "vlan_test" {
     ])
   }
 }
+
+resource "netbox_ipam_vlan" "dynamic_vlan_test" {
+  name = "DynamicVlan"
+  vlan_group_id = netbox_ipam_vlan_group.vlan_group_test.id
+  description = "Dynamic VLAN created by terraform"
+}
@@ -0,0 +1,2 @@
+# IKE policies can be imported by id
+terraform import netbox_vpn_ike_policy.ike_policy_test 1
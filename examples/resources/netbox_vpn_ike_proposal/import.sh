@@ -0,0 +1,2 @@
+# IKE proposals can be imported by id
+terraform import netbox_vpn_ike_proposal.ike_proposal_test 1
@@ -0,0 +1,2 @@
+# IPSec policies can be imported by id
+terraform import netbox_vpn_ipsec_policy.ipsec_policy_test 1
@@ -0,0 +1,2 @@
+# IPSec profiles can be imported by id
+terraform import netbox_vpn_ipsec_profile.ipsec_profile_test 1
@@ -0,0 +1,2 @@
+# IPSec proposals can be imported by id
+terraform import netbox_vpn_ipsec_proposal.ipsec_proposal_test 1
@@ -0,0 +1,2 @@
+# L2VPNs can be imported by id
+terraform import netbox_vpn_l2vpn.l2vpn_test 1
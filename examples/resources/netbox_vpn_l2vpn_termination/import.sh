@@ -0,0 +1,2 @@
+# L2VPN terminations can be imported by id
+terraform import netbox_vpn_l2vpn_termination.l2vpn_termination_test 1
@@ -0,0 +1,2 @@
+# Tunnels can be imported by id
+terraform import netbox_vpn_tunnel.tunnel_test 1
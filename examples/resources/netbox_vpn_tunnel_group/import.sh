@@ -0,0 +1,2 @@
+# Tunnel groups can be imported by id
+terraform import netbox_vpn_tunnel_group.tunnel_group_test 1
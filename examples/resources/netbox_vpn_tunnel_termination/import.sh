@@ -0,0 +1,2 @@
+# Tunnel terminations can be imported by id
+terraform import netbox_vpn_tunnel_termination.tunnel_termination_test 1
@@ -0,0 +1,2 @@
+# Wireless LANs can be imported by id
+terraform import netbox_wireless_lan.test 1
@@ -0,0 +1,2 @@
+# Wireless LAN groups can be imported by id
+terraform import netbox_wireless_lan_group.test 1
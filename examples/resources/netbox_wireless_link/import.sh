@@ -0,0 +1,2 @@
+# Wireless links can be imported by id
+terraform import netbox_wireless_link.test 1
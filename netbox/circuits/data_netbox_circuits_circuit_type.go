@@ -0,0 +1,75 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxCircuitsCircuitType() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about circuit type from netbox.",
+		ReadContext: dataNetboxCircuitsCircuitTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this circuit type.",
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9_]{1,100}$"),
+					"Must be like ^[-a-zA-Z0-9_]{1,100}$"),
+				Description: "The slug of the circuit type.",
+			},
+		},
+	}
+}
+
+func dataNetboxCircuitsCircuitTypeRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	slug := []string{d.Get("slug").(string)}
+
+	resource, response, err := client.CircuitsAPI.CircuitsCircuitTypesList(
+		ctx).Slug(slug).Execute()
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if resource.GetCount() < 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned no results. "+
+				"Please change your search criteria and try again."))
+
+	} else if resource.GetCount() > 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned more than one result. "+
+				"Please try a more specific search criteria."))
+	}
+
+	r := resource.Results[0]
+	d.SetId(fmt.Sprintf("%d", r.GetId()))
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(r.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxCircuitsProviderAccount() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about provider account from netbox.",
+		ReadContext: dataNetboxCircuitsProviderAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The account ID of the provider account.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this provider account.",
+			},
+			"provider_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the provider of the provider account.",
+			},
+		},
+	}
+}
+
+func dataNetboxCircuitsProviderAccountRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	providerID, err := safecast.ToInt32(d.Get("provider_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	account := []string{d.Get("account").(string)}
+
+	resource, response, err := client.CircuitsAPI.CircuitsProviderAccountsList(
+		ctx).ProviderId([]int32{providerID}).Account(account).Execute()
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if resource.GetCount() < 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned no results. "+
+				"Please change your search criteria and try again."))
+
+	} else if resource.GetCount() > 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned more than one result. "+
+				"Please try a more specific search criteria."))
+	}
+
+	r := resource.Results[0]
+	d.SetId(fmt.Sprintf("%d", r.GetId()))
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(r.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
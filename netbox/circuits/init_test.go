@@ -0,0 +1,19 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox"
+)
+
+var testAccProviders map[string]*schema.Provider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = netbox.Provider()
+	testAccProviders = map[string]*schema.Provider{
+		"netbox": testAccProvider,
+	}
+}
@@ -0,0 +1,488 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+var dateRegexp = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+func ResourceNetboxCircuitsCircuit() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a circuit within Netbox.",
+		CreateContext: resourceNetboxCircuitsCircuitCreate,
+		ReadContext:   resourceNetboxCircuitsCircuitRead,
+		UpdateContext: resourceNetboxCircuitsCircuitUpdate,
+		DeleteContext: resourceNetboxCircuitsCircuitDelete,
+		Exists:        resourceNetboxCircuitsCircuitExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The unique circuit ID of this circuit.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this circuit.",
+			},
+			"commit_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The committed rate (Kbps) of this circuit.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this circuit.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this circuit was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this circuit.",
+			},
+			"install_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(dateRegexp,
+					"Must be like YYYY-MM-DD"),
+				Description: "The date when this circuit was installed " +
+					"(YYYY-MM-DD).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this circuit was last updated.",
+			},
+			"provider_account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the provider account of this circuit.",
+			},
+			"provider_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the provider of this circuit.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedCircuitStatusValueEnumValues), false),
+				Description: "The status among planned, provisioning, " +
+					"active, offline, deprovisioning or decommissioned " +
+					"(active by default) of this circuit.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this circuit.",
+			},
+			"termination_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(dateRegexp,
+					"Must be like YYYY-MM-DD"),
+				Description: "The date when this circuit is terminated " +
+					"(YYYY-MM-DD).",
+			},
+			"type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the circuit type of this circuit.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this circuit.",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxCircuitsCircuitCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	p, errDiag := brief.GetBriefProviderRequestFromID(ctx, client,
+		d.Get("provider_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	t, errDiag := brief.GetBriefCircuitTypeRequestFromID(ctx, client,
+		d.Get("type_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewWritableCircuitRequest(d.Get("cid").(string),
+		*p, *t)
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if commitRate, exist := d.GetOk("commit_rate"); exist {
+		commitRate32, err := safecast.ToInt32(commitRate.(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetCommitRate(commitRate32)
+	}
+
+	if installDate := d.Get("install_date").(string); installDate != "" {
+		newResource.SetInstallDate(installDate)
+	}
+
+	if accountID := d.Get("provider_account_id").(int); accountID != 0 {
+		b, errDiag := brief.GetBriefProviderAccountRequestFromID(ctx, client,
+			accountID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetProviderAccount(*b)
+	}
+
+	status, err := netbox.NewCircuitStatusValueFromValue(
+		d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetStatus(*status)
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetTenant(*b)
+	}
+
+	if date := d.Get("termination_date").(string); date != "" {
+		newResource.SetTerminationDate(date)
+	}
+
+	_, response, err := client.CircuitsAPI.CircuitsCircuitsCreate(
+		ctx).WritableCircuitRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxCircuitsCircuitRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsCircuitRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.CircuitsAPI.CircuitsCircuitsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("cid", resource.GetCid()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("commit_rate", resource.GetCommitRate()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("install_date", resource.GetInstallDate()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("provider_account_id",
+		resource.GetProviderAccount().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("provider_id", resource.GetProvider().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("termination_date",
+		resource.GetTerminationDate()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("type_id", resource.GetType().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxCircuitsCircuitUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableCircuitRequestWithDefaults()
+
+	// Required fields
+	resource.SetCid(d.Get("cid").(string))
+
+	p, errDiag := brief.GetBriefProviderRequestFromID(ctx, client,
+		d.Get("provider_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetProvider(*p)
+
+	t, errDiag := brief.GetBriefCircuitTypeRequestFromID(ctx, client,
+		d.Get("type_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetType(*t)
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("commit_rate") {
+		if commitRate, exist := d.GetOk("commit_rate"); exist {
+			commitRate32, err := safecast.ToInt32(commitRate.(int))
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetCommitRate(commitRate32)
+		} else {
+			resource.SetCommitRateNil()
+		}
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("install_date") {
+		if installDate := d.Get("install_date").(string); installDate != "" {
+			resource.SetInstallDate(installDate)
+		} else {
+			resource.SetInstallDateNil()
+		}
+	}
+
+	if d.HasChange("provider_account_id") {
+		if accountID := d.Get("provider_account_id").(int); accountID != 0 {
+			b, errDiag := brief.GetBriefProviderAccountRequestFromID(ctx,
+				client, accountID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetProviderAccount(*b)
+		} else {
+			resource.SetProviderAccountNil()
+		}
+	}
+
+	if d.HasChange("status") {
+		status, err := netbox.NewCircuitStatusValueFromValue(
+			d.Get("status").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetStatus(*status)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client,
+				tenantID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if d.HasChange("termination_date") {
+		if date := d.Get("termination_date").(string); date != "" {
+			resource.SetTerminationDate(date)
+		} else {
+			resource.SetTerminationDateNil()
+		}
+	}
+
+	if _, response, err := client.CircuitsAPI.CircuitsCircuitsUpdate(ctx,
+		int32(resourceID)).WritableCircuitRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxCircuitsCircuitRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsCircuitDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxCircuitsCircuitExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.CircuitsAPI.CircuitsCircuitsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.CircuitsAPI.CircuitsCircuitsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,468 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxCircuitsCircuitTermination() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a termination of a circuit within Netbox.",
+		CreateContext: resourceNetboxCircuitsCircuitTerminationCreate,
+		ReadContext:   resourceNetboxCircuitsCircuitTerminationRead,
+		UpdateContext: resourceNetboxCircuitsCircuitTerminationUpdate,
+		DeleteContext: resourceNetboxCircuitsCircuitTerminationDelete,
+		Exists:        resourceNetboxCircuitsCircuitTerminationExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"circuit_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the circuit of this circuit termination.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this circuit termination.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this circuit termination was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this circuit termination.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this circuit termination was last " +
+					"updated.",
+			},
+			"mark_connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Treat as if a cable is connected to this " +
+					"circuit termination (false by default).",
+			},
+			"port_speed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The physical circuit speed (Kbps) of this " +
+					"circuit termination.",
+			},
+			"pp_info": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const100),
+				Description: "The patch panel ID and port number(s) of this " +
+					"circuit termination.",
+			},
+			"provider_network_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"provider_network_id", "site_id"},
+				Description: "ID of the provider network where this circuit " +
+					"is terminated.",
+			},
+			"site_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"provider_network_id", "site_id"},
+				Description: "ID of the site where this circuit is " +
+					"terminated.",
+			},
+			"tag": &tag.TagSchema,
+			"term_side": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedTermination1EnumValues), false),
+				Description: "The side (A or Z) of this circuit termination.",
+			},
+			"upstream_speed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The upstream speed (Kbps), if different from " +
+					"the port speed, of this circuit termination.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this circuit termination.",
+			},
+			"xconnect_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "The cross-connect ID of this circuit termination.",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxCircuitsCircuitTerminationCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	c, errDiag := brief.GetBriefCircuitRequestFromID(ctx, client,
+		d.Get("circuit_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	termSide, err := netbox.NewTermination1FromValue(
+		d.Get("term_side").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewCircuitTerminationRequest(*c, *termSide)
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetMarkConnected(d.Get("mark_connected").(bool))
+	newResource.SetPpInfo(d.Get("pp_info").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	newResource.SetXconnectId(d.Get("xconnect_id").(string))
+
+	if portSpeed, exist := d.GetOk("port_speed"); exist {
+		portSpeed32, err := safecast.ToInt32(portSpeed.(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetPortSpeed(portSpeed32)
+	}
+
+	if networkID := d.Get("provider_network_id").(int); networkID != 0 {
+		b, errDiag := brief.GetBriefProviderNetworkRequestFromID(ctx, client,
+			networkID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetProviderNetwork(*b)
+	}
+
+	if siteID := d.Get("site_id").(int); siteID != 0 {
+		b, errDiag := brief.GetBriefSiteRequestFromID(ctx, client, siteID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetSite(*b)
+	}
+
+	if upstreamSpeed, exist := d.GetOk("upstream_speed"); exist {
+		upstreamSpeed32, err := safecast.ToInt32(upstreamSpeed.(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetUpstreamSpeed(upstreamSpeed32)
+	}
+
+	_, response, err := client.CircuitsAPI.CircuitsCircuitTerminationsCreate(
+		ctx).CircuitTerminationRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxCircuitsCircuitTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsCircuitTerminationRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err :=
+		client.CircuitsAPI.CircuitsCircuitTerminationsRetrieve(ctx,
+			int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("circuit_id", resource.GetCircuit().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mark_connected",
+		resource.GetMarkConnected()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("port_speed", resource.GetPortSpeed()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("pp_info", resource.GetPpInfo()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("provider_network_id",
+		resource.GetProviderNetwork().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("site_id", resource.GetSite().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("term_side", resource.GetTermSide()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("upstream_speed", resource.GetUpstreamSpeed()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("xconnect_id", resource.GetXconnectId()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxCircuitsCircuitTerminationUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewCircuitTerminationRequestWithDefaults()
+
+	// Required fields
+	c, errDiag := brief.GetBriefCircuitRequestFromID(ctx, client,
+		d.Get("circuit_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetCircuit(*c)
+
+	termSide, err := netbox.NewTermination1FromValue(
+		d.Get("term_side").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetTermSide(*termSide)
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("mark_connected") {
+		resource.SetMarkConnected(d.Get("mark_connected").(bool))
+	}
+
+	if d.HasChange("port_speed") {
+		if portSpeed, exist := d.GetOk("port_speed"); exist {
+			portSpeed32, err := safecast.ToInt32(portSpeed.(int))
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetPortSpeed(portSpeed32)
+		} else {
+			resource.SetPortSpeedNil()
+		}
+	}
+
+	if d.HasChange("pp_info") {
+		resource.SetPpInfo(d.Get("pp_info").(string))
+	}
+
+	if d.HasChange("provider_network_id") {
+		if networkID := d.Get("provider_network_id").(int); networkID != 0 {
+			b, errDiag := brief.GetBriefProviderNetworkRequestFromID(ctx,
+				client, networkID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetProviderNetwork(*b)
+		} else {
+			resource.SetProviderNetworkNil()
+		}
+	}
+
+	if d.HasChange("site_id") {
+		if siteID := d.Get("site_id").(int); siteID != 0 {
+			b, errDiag := brief.GetBriefSiteRequestFromID(ctx, client, siteID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetSite(*b)
+		} else {
+			resource.SetSiteNil()
+		}
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("upstream_speed") {
+		if upstreamSpeed, exist := d.GetOk("upstream_speed"); exist {
+			upstreamSpeed32, err := safecast.ToInt32(upstreamSpeed.(int))
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetUpstreamSpeed(upstreamSpeed32)
+		} else {
+			resource.SetUpstreamSpeedNil()
+		}
+	}
+
+	if d.HasChange("xconnect_id") {
+		resource.SetXconnectId(d.Get("xconnect_id").(string))
+	}
+
+	if _, response, err :=
+		client.CircuitsAPI.CircuitsCircuitTerminationsUpdate(ctx,
+			int32(resourceID)).CircuitTerminationRequest(
+			*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxCircuitsCircuitTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsCircuitTerminationDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxCircuitsCircuitTerminationExists(d,
+		m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err :=
+		client.CircuitsAPI.CircuitsCircuitTerminationsDestroy(ctx,
+			int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTerminationExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.CircuitsAPI.CircuitsCircuitTerminationsRetrieve(
+		nil, int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,170 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxCircuitsCircuitTermination = "" +
+	"netbox_circuits_circuit_termination.test"
+
+func TestAccNetboxCircuitsCircuitTerminationMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsCircuitTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsCircuitTerminationFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsCircuitTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsCircuitTerminationMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitTermination),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxCircuitsCircuitTerminationConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_circuits_provider" "test" {
+		name = "circuitstermination-{{ .namesuffix }}"
+		slug = "circuitstermination-{{ .namesuffix }}"
+	}
+
+	resource "netbox_circuits_circuit_type" "test" {
+		name = "circuitstermination-{{ .namesuffix }}"
+		slug = "circuitstermination-{{ .namesuffix }}"
+	}
+
+	resource "netbox_circuits_circuit" "test" {
+		cid         = "circuitstermination-{{ .namesuffix }}"
+		provider_id = netbox_circuits_provider.test.id
+		type_id     = netbox_circuits_circuit_type.test.id
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "circuitstermination-{{ .namesuffix }}"
+		slug = "circuitstermination-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "circuitstermination-{{ .namesuffix }}"
+		slug = "circuitstermination-{{ .namesuffix }}"
+	}
+
+	resource "netbox_circuits_provider_network" "test" {
+		name        = "circuitstermination-{{ .namesuffix }}"
+		provider_id = netbox_circuits_provider.test.id
+	}
+	{{ end }}
+
+	resource "netbox_circuits_circuit_termination" "test" {
+		circuit_id = netbox_circuits_circuit.test.id
+		term_side  = "A"
+
+		{{ if eq .resourcefull "true" }}
+		description = "Test circuit termination"
+		mark_connected = true
+		port_speed = 1000000
+		pp_info = "PP1 port 12"
+		provider_network_id = netbox_circuits_provider_network.test.id
+		upstream_speed = 500000
+		xconnect_id = "XC-{{ .namesuffix }}"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ else }}
+		site_id = netbox_dcim_site.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,166 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxCircuitsCircuit = "netbox_circuits_circuit.test"
+
+func TestAccNetboxCircuitsCircuitMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuit),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsCircuit,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsCircuitFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuit),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsCircuit,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsCircuitMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuit),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuit),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuit),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuit),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxCircuitsCircuitConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_circuits_provider" "test" {
+		name = "circuitscircuit-{{ .namesuffix }}"
+		slug = "circuitscircuit-{{ .namesuffix }}"
+	}
+
+	resource "netbox_circuits_circuit_type" "test" {
+		name = "circuitscircuit-{{ .namesuffix }}"
+		slug = "circuitscircuit-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "circuitscircuit-{{ .namesuffix }}"
+		slug = "circuitscircuit-{{ .namesuffix }}"
+	}
+
+	resource "netbox_circuits_provider_account" "test" {
+		account     = "circuitscircuit-{{ .namesuffix }}"
+		provider_id = netbox_circuits_provider.test.id
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "circuitscircuit-{{ .namesuffix }}"
+		slug = "circuitscircuit-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_circuits_circuit" "test" {
+		cid         = "circuitscircuit-{{ .namesuffix }}"
+		provider_id = netbox_circuits_provider.test.id
+		type_id     = netbox_circuits_circuit_type.test.id
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Circuit
+		Multiline
+		EOT
+		commit_rate = 100000
+		description = "Test circuit"
+		install_date = "2024-01-15"
+		provider_account_id = netbox_circuits_provider_account.test.id
+		status = "planned"
+		tenant_id = netbox_tenancy_tenant.test.id
+		termination_date = "2027-01-15"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
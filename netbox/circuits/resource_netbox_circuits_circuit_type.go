@@ -0,0 +1,298 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxCircuitsCircuitType() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a circuit type within Netbox.",
+		CreateContext: resourceNetboxCircuitsCircuitTypeCreate,
+		ReadContext:   resourceNetboxCircuitsCircuitTypeRead,
+		UpdateContext: resourceNetboxCircuitsCircuitTypeUpdate,
+		DeleteContext: resourceNetboxCircuitsCircuitTypeDelete,
+		Exists:        resourceNetboxCircuitsCircuitTypeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"circuit_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The number of circuits with this circuit " +
+					"type.",
+			},
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 6),
+					validation.StringMatch(
+						regexp.MustCompile("^[0-9a-f]{1,6}$"),
+						"^[0-9a-f]{1,6})$")),
+				Description: "The color of this circuit type.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this circuit type.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this circuit type was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this circuit type.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this circuit type was last " +
+					"updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this circuit type.",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The slug of this circuit type.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this circuit type.",
+			},
+		},
+	}
+}
+
+func resourceNetboxCircuitsCircuitTypeCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := netbox.NewCircuitTypeRequestWithDefaults()
+	newResource.SetColor(d.Get("color").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetName(name)
+	newResource.SetSlug(slug)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	_, response, err :=
+		client.CircuitsAPI.CircuitsCircuitTypesCreate(
+			ctx).CircuitTypeRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxCircuitsCircuitTypeRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsCircuitTypeRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.CircuitsAPI.CircuitsCircuitTypesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("circuit_count",
+		resource.GetCircuitCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("color", resource.GetColor()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields,
+		resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("slug", resource.GetSlug()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTypeUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewCircuitTypeRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+	resource.SetSlug(d.Get("slug").(string))
+
+	if d.HasChange("color") {
+		resource.SetColor(d.Get("color").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields :=
+			customfield.ConvertCustomFieldsFromTerraformToAPI(
+				stateCustomFields.(*schema.Set).List(),
+				resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.CircuitsAPI.CircuitsCircuitTypesUpdate(ctx,
+		int32(resourceID)).CircuitTypeRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxCircuitsCircuitTypeRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsCircuitTypeDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxCircuitsCircuitTypeExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.CircuitsAPI.CircuitsCircuitTypesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTypeExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.CircuitsAPI.CircuitsCircuitTypesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
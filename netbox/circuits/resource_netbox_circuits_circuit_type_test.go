@@ -0,0 +1,137 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxCircuitsCircuitType = "" +
+	"netbox_circuits_circuit_type.test"
+
+func TestAccNetboxCircuitsCircuitTypeMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsCircuitType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsCircuitTypeFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsCircuitType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsCircuitTypeMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsCircuitType),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxCircuitsCircuitTypeConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "circuitstype-{{ .namesuffix }}"
+		slug = "circuitstype-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_circuits_circuit_type" "test" {
+		name = "circuitstype-{{ .namesuffix }}"
+		slug = "circuitstype-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		color = "00ff00"
+		description = "Test circuit type"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,332 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxCircuitsProvider() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a circuit provider within Netbox.",
+		CreateContext: resourceNetboxCircuitsProviderCreate,
+		ReadContext:   resourceNetboxCircuitsProviderRead,
+		UpdateContext: resourceNetboxCircuitsProviderUpdate,
+		DeleteContext: resourceNetboxCircuitsProviderDelete,
+		Exists:        resourceNetboxCircuitsProviderExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "IDs of the ASNs of this provider.",
+			},
+			"circuit_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of circuits of this provider.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this provider.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this provider.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this provider was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this provider.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this provider was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this provider.",
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9_]{1,100}$"),
+					"Must be like ^[-a-zA-Z0-9_]{1,100}$"),
+				Description: "The slug of this provider.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this provider.",
+			},
+		},
+	}
+}
+
+func getProviderASNs(asns []any) ([]int32, error) {
+	asns32 := []int32{}
+	for _, asn := range asns {
+		asn32, err := safecast.ToInt32(asn.(int))
+		if err != nil {
+			return nil, err
+		}
+		asns32 = append(asns32, asn32)
+	}
+
+	return asns32, nil
+}
+
+func resourceNetboxCircuitsProviderCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	asns, err := getProviderASNs(d.Get("asns").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewProviderRequest(d.Get("name").(string),
+		d.Get("slug").(string))
+	newResource.SetAsns(asns)
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	_, response, err := client.CircuitsAPI.CircuitsProvidersCreate(
+		ctx).ProviderRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxCircuitsProviderRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsProviderRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.CircuitsAPI.CircuitsProvidersRetrieve(
+		ctx, int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	asns := []int32{}
+	for _, asn := range resource.GetAsns() {
+		asns = append(asns, asn.GetId())
+	}
+
+	if err = d.Set("asns", asns); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("circuit_count", resource.GetCircuitCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("slug", resource.GetSlug()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewProviderRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+	resource.SetSlug(d.Get("slug").(string))
+
+	if d.HasChange("asns") {
+		asns, err := getProviderASNs(d.Get("asns").(*schema.Set).List())
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAsns(asns)
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.CircuitsAPI.CircuitsProvidersUpdate(ctx,
+		int32(resourceID)).ProviderRequest(*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxCircuitsProviderRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsProviderDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxCircuitsProviderExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.CircuitsAPI.CircuitsProvidersDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.CircuitsAPI.CircuitsProvidersRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,304 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxCircuitsProviderAccount() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an account of a circuit provider within Netbox.",
+		CreateContext: resourceNetboxCircuitsProviderAccountCreate,
+		ReadContext:   resourceNetboxCircuitsProviderAccountRead,
+		UpdateContext: resourceNetboxCircuitsProviderAccountUpdate,
+		DeleteContext: resourceNetboxCircuitsProviderAccountDelete,
+		Exists:        resourceNetboxCircuitsProviderAccountExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The account ID of this provider account.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this provider account.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this provider account.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this provider account was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this provider account.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this provider account was last " +
+					"updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const100),
+				Description:  "The name of this provider account.",
+			},
+			"provider_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the provider of this provider account.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this provider account.",
+			},
+		},
+	}
+}
+
+func resourceNetboxCircuitsProviderAccountCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	p, errDiag := brief.GetBriefProviderRequestFromID(ctx, client,
+		d.Get("provider_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewProviderAccountRequest(*p,
+		d.Get("account").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetName(d.Get("name").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	_, response, err := client.CircuitsAPI.CircuitsProviderAccountsCreate(
+		ctx).ProviderAccountRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxCircuitsProviderAccountRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsProviderAccountRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err :=
+		client.CircuitsAPI.CircuitsProviderAccountsRetrieve(ctx,
+			int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("account", resource.GetAccount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("provider_id", resource.GetProvider().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderAccountUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewProviderAccountRequestWithDefaults()
+
+	// Required fields
+	p, errDiag := brief.GetBriefProviderRequestFromID(ctx, client,
+		d.Get("provider_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetProvider(*p)
+	resource.SetAccount(d.Get("account").(string))
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("name") {
+		resource.SetName(d.Get("name").(string))
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.CircuitsAPI.CircuitsProviderAccountsUpdate(
+		ctx, int32(resourceID)).ProviderAccountRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxCircuitsProviderAccountRead(ctx, d, m)
+}
+
+func resourceNetboxCircuitsProviderAccountDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxCircuitsProviderAccountExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.CircuitsAPI.CircuitsProviderAccountsDestroy(
+		ctx, int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderAccountExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.CircuitsAPI.CircuitsProviderAccountsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,146 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxCircuitsProviderAccount = "" +
+	"netbox_circuits_provider_account.test"
+
+func TestAccNetboxCircuitsProviderAccountMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderAccount),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsProviderAccount,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsProviderAccountFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderAccount),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsProviderAccount,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsProviderAccountMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderAccount),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderAccount),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderAccount),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderAccount),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxCircuitsProviderAccountConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_circuits_provider" "test" {
+		name = "circuitsaccount-{{ .namesuffix }}"
+		slug = "circuitsaccount-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "circuitsaccount-{{ .namesuffix }}"
+		slug = "circuitsaccount-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_circuits_provider_account" "test" {
+		account     = "circuitsaccount-{{ .namesuffix }}"
+		provider_id = netbox_circuits_provider.test.id
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Provider Account
+		Multiline
+		EOT
+		description = "Test provider account"
+		name = "circuitsaccount-{{ .namesuffix }}"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,146 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package circuits_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxCircuitsProviderNetwork = "" +
+	"netbox_circuits_provider_network.test"
+
+func TestAccNetboxCircuitsProviderNetworkMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderNetwork),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsProviderNetwork,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsProviderNetworkFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderNetwork),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxCircuitsProviderNetwork,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxCircuitsProviderNetworkMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderNetwork),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderNetwork),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderNetwork),
+				),
+			},
+			{
+				Config: testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxCircuitsProviderNetwork),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxCircuitsProviderNetworkConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_circuits_provider" "test" {
+		name = "circuitsnetwork-{{ .namesuffix }}"
+		slug = "circuitsnetwork-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "circuitsnetwork-{{ .namesuffix }}"
+		slug = "circuitsnetwork-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_circuits_provider_network" "test" {
+		name        = "circuitsnetwork-{{ .namesuffix }}"
+		provider_id = netbox_circuits_provider.test.id
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Provider Network
+		Multiline
+		EOT
+		description = "Test provider network"
+		service_id = "circuitsnetwork-{{ .namesuffix }}"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,1088 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+// Device types and module types own component templates which are
+// reconciled one by one with the nested blocks of the resource. Templates
+// are matched by name as Netbox requires the name to be unique within a
+// device or module type.
+
+const maxRearPortPositions = 1024
+
+var colorRegexp = regexp.MustCompile("^[0-9a-f]{6}$")
+
+// Rear ports have to exist before the front ports mapped to them are
+// created and the front ports have to be deleted before their rear ports.
+var componentTemplatesCreateOrder = []string{
+	"rear_port_template",
+	"front_port_template",
+	"interface_template",
+	"console_port_template",
+	"power_port_template",
+	"module_bay_template",
+}
+
+var componentTemplatesDeleteOrder = []string{
+	"front_port_template",
+	"rear_port_template",
+	"interface_template",
+	"console_port_template",
+	"power_port_template",
+	"module_bay_template",
+}
+
+type templateParent struct {
+	id         int32
+	module     bool
+	deviceType *netbox.BriefDeviceTypeRequest
+	moduleType *netbox.BriefModuleTypeRequest
+}
+
+type listTemplatesFunc func(context.Context, *netbox.APIClient,
+	*templateParent) ([]map[string]any, diag.Diagnostics)
+
+// writeTemplateFunc creates the template when the ID is 0 and updates it
+// otherwise.
+type writeTemplateFunc func(context.Context, *netbox.APIClient,
+	*templateParent, int32, map[string]any) diag.Diagnostics
+
+type destroyTemplateFunc func(context.Context, *netbox.APIClient,
+	int32) diag.Diagnostics
+
+type componentTemplate struct {
+	list    listTemplatesFunc
+	write   writeTemplateFunc
+	destroy destroyTemplateFunc
+}
+
+var componentTemplates = map[string]componentTemplate{
+	"console_port_template": {
+		list:    listConsolePortTemplates,
+		write:   writeConsolePortTemplate,
+		destroy: destroyConsolePortTemplate,
+	},
+	"front_port_template": {
+		list:    listFrontPortTemplates,
+		write:   writeFrontPortTemplate,
+		destroy: destroyFrontPortTemplate,
+	},
+	"interface_template": {
+		list:    listInterfaceTemplates,
+		write:   writeInterfaceTemplate,
+		destroy: destroyInterfaceTemplate,
+	},
+	"module_bay_template": {
+		list:    listModuleBayTemplates,
+		write:   writeModuleBayTemplate,
+		destroy: destroyModuleBayTemplate,
+	},
+	"power_port_template": {
+		list:    listPowerPortTemplates,
+		write:   writePowerPortTemplate,
+		destroy: destroyPowerPortTemplate,
+	},
+	"rear_port_template": {
+		list:    listRearPortTemplates,
+		write:   writeRearPortTemplate,
+		destroy: destroyRearPortTemplate,
+	},
+}
+
+func templateIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of this template.",
+	}
+}
+
+func templateNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, util.Const64),
+		Description: "The name of this template, unique within the " +
+			"device or module type.",
+	}
+}
+
+func templateLabelSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, util.Const64),
+		Description:  "The physical label of this template.",
+	}
+}
+
+func templateDescriptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, util.Const200),
+		Description:  "The description of this template.",
+	}
+}
+
+func templateColorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(colorRegexp, "^[0-9a-f]{6}$"),
+		Description:  "The color of this template (hexadecimal).",
+	}
+}
+
+func consolePortTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Console port templates of this object.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": templateDescriptionSchema(),
+				"id":          templateIDSchema(),
+				"label":       templateLabelSchema(),
+				"name":        templateNameSchema(),
+				"type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(
+							netbox.AllowedConsolePortTypeValueEnumValues),
+						false),
+					Description: "The type of this console port template " +
+						"(de-9, rj-45, usb-c, ...).",
+				},
+			},
+		},
+	}
+}
+
+func frontPortTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Front port templates of this object.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"color":       templateColorSchema(),
+				"description": templateDescriptionSchema(),
+				"id":          templateIDSchema(),
+				"label":       templateLabelSchema(),
+				"name":        templateNameSchema(),
+				"rear_port": {
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "The name of the rear port template " +
+						"mapped to this front port template.",
+				},
+				"rear_port_position": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+					ValidateFunc: validation.IntBetween(1,
+						maxRearPortPositions),
+					Description: "The position on the rear port mapped " +
+						"to this front port template.",
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(
+							netbox.AllowedFrontPortTypeValueEnumValues),
+						false),
+					Description: "The type of this front port template " +
+						"(8p8c, lc, sc, ...).",
+				},
+			},
+		},
+	}
+}
+
+func interfaceTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Interface templates of this object.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": templateDescriptionSchema(),
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Is this interface template enabled.",
+				},
+				"id":    templateIDSchema(),
+				"label": templateLabelSchema(),
+				"mgmt_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+					Description: "Is this interface template only used " +
+						"for out-of-band management.",
+				},
+				"name": templateNameSchema(),
+				"poe_mode": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(
+							netbox.AllowedInterfacePoeModeValueEnumValues),
+						false),
+					Description: "The PoE mode among pd or pse of this " +
+						"interface template.",
+				},
+				"poe_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(
+							netbox.AllowedInterfacePoeTypeValueEnumValues),
+						false),
+					Description: "The PoE type of this interface template.",
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(
+							netbox.AllowedInterfaceTypeValueEnumValues),
+						false),
+					Description: "The type of this interface template " +
+						"(virtual, 1000base-t, 10gbase-x-sfpp, ...).",
+				},
+			},
+		},
+	}
+}
+
+func moduleBayTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Module bay templates of this object.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": templateDescriptionSchema(),
+				"id":          templateIDSchema(),
+				"label":       templateLabelSchema(),
+				"name":        templateNameSchema(),
+				"position": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, util.Const32),
+					Description: "Identifier to reference when renaming " +
+						"installed components.",
+				},
+			},
+		},
+	}
+}
+
+func powerPortTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Power port templates of this object.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allocated_draw": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description: "Allocated power draw (watts) of this " +
+						"power port template.",
+				},
+				"description": templateDescriptionSchema(),
+				"id":          templateIDSchema(),
+				"label":       templateLabelSchema(),
+				"maximum_draw": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description: "Maximum power draw (watts) of this " +
+						"power port template.",
+				},
+				"name": templateNameSchema(),
+				"type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(netbox.
+							AllowedPatchedWritablePowerPortTemplateRequestTypeEnumValues),
+						false),
+					Description: "The type of this power port template " +
+						"(iec-60320-c14, nema-5-15p, ...).",
+				},
+			},
+		},
+	}
+}
+
+func rearPortTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Rear port templates of this object.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"color":       templateColorSchema(),
+				"description": templateDescriptionSchema(),
+				"id":          templateIDSchema(),
+				"label":       templateLabelSchema(),
+				"name":        templateNameSchema(),
+				"positions": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+					ValidateFunc: validation.IntBetween(1,
+						maxRearPortPositions),
+					Description: "The number of front ports which may be " +
+						"mapped to this rear port template.",
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						util.EnumToListofStrings(
+							netbox.AllowedFrontPortTypeValueEnumValues),
+						false),
+					Description: "The type of this rear port template " +
+						"(8p8c, lc, sc, ...).",
+				},
+			},
+		},
+	}
+}
+
+// reconcileComponentTemplates creates, updates and deletes the templates of
+// the parent so that they match the nested blocks of the resource.
+func reconcileComponentTemplates(ctx context.Context,
+	client *netbox.APIClient, d *schema.ResourceData,
+	parent *templateParent, keys []string) diag.Diagnostics {
+
+	templates := map[string][]any{}
+	for _, key := range keys {
+		if d.HasChange(key) {
+			templates[key] = d.Get(key).([]any)
+		}
+	}
+
+	return applyComponentTemplates(ctx, client, parent, templates)
+}
+
+// applyComponentTemplates makes the templates of the parent match the given
+// ones for each kind of template present in the map. The templates of the
+// other kinds are left untouched.
+func applyComponentTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, templates map[string][]any) diag.Diagnostics {
+
+	for _, key := range componentTemplatesDeleteOrder {
+		desiredTemplates, ok := templates[key]
+		if !ok {
+			continue
+		}
+
+		desired := map[string]bool{}
+		for _, elem := range desiredTemplates {
+			desired[elem.(map[string]any)["name"].(string)] = true
+		}
+
+		current, errDiag := componentTemplates[key].list(ctx, client, parent)
+		if errDiag != nil {
+			return errDiag
+		}
+
+		for _, c := range current {
+			if desired[c["name"].(string)] {
+				continue
+			}
+
+			id32, err := safecast.ToInt32(c["id"].(int))
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+
+			if errDiag := componentTemplates[key].destroy(ctx, client,
+				id32); errDiag != nil {
+				return errDiag
+			}
+		}
+	}
+
+	for _, key := range componentTemplatesCreateOrder {
+		desiredTemplates, ok := templates[key]
+		if !ok {
+			continue
+		}
+
+		current, errDiag := componentTemplates[key].list(ctx, client, parent)
+		if errDiag != nil {
+			return errDiag
+		}
+
+		currentByName := map[string]map[string]any{}
+		for _, c := range current {
+			currentByName[c["name"].(string)] = c
+		}
+
+		for _, elem := range desiredTemplates {
+			e := elem.(map[string]any)
+
+			var id32 int32
+			if c, ok := currentByName[e["name"].(string)]; ok {
+				if !componentTemplateChanged(c, e) {
+					continue
+				}
+
+				var err error
+				if id32, err = safecast.ToInt32(c["id"].(int)); err != nil {
+					return util.GenerateErrorMessage(nil, err)
+				}
+			}
+
+			if errDiag := componentTemplates[key].write(ctx, client, parent,
+				id32, e); errDiag != nil {
+				return errDiag
+			}
+		}
+	}
+
+	return nil
+}
+
+func componentTemplateChanged(current, desired map[string]any) bool {
+	for k, v := range desired {
+		if k != "id" && current[k] != v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readComponentTemplates sets the templates of the parent in the state, the
+// templates declared in the configuration keep their order.
+func readComponentTemplates(ctx context.Context, client *netbox.APIClient,
+	d *schema.ResourceData, parent *templateParent,
+	keys []string) diag.Diagnostics {
+
+	for _, key := range keys {
+		current, err := componentTemplates[key].list(ctx, client, parent)
+		if err != nil {
+			return err
+		}
+
+		currentByName := map[string]map[string]any{}
+		for _, c := range current {
+			currentByName[c["name"].(string)] = c
+		}
+
+		templates := []map[string]any{}
+		for _, elem := range d.Get(key).([]any) {
+			name := elem.(map[string]any)["name"].(string)
+			if c, ok := currentByName[name]; ok {
+				templates = append(templates, c)
+				delete(currentByName, name)
+			}
+		}
+
+		for _, c := range current {
+			if _, ok := currentByName[c["name"].(string)]; ok {
+				templates = append(templates, c)
+			}
+		}
+
+		if err := d.Set(key, templates); err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+	}
+
+	return nil
+}
+
+func newDeviceTypeTemplateParent(ctx context.Context,
+	client *netbox.APIClient, id int) (*templateParent, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	b, errDiag := brief.GetBriefDeviceTypeRequestFromID(ctx, client, id)
+	if errDiag != nil {
+		return nil, errDiag
+	}
+
+	return &templateParent{id: id32, deviceType: b}, nil
+}
+
+func newModuleTypeTemplateParent(ctx context.Context,
+	client *netbox.APIClient, id int) (*templateParent, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	b, errDiag := brief.GetBriefModuleTypeRequestFromID(ctx, client, id)
+	if errDiag != nil {
+		return nil, errDiag
+	}
+
+	return &templateParent{id: id32, module: true, moduleType: b},
+		nil
+}
+
+func listConsolePortTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent) ([]map[string]any, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimConsolePortTemplatesList(ctx).Limit(
+		util.Const1000)
+	if parent.module {
+		request = request.ModuleTypeId([]*int32{&parent.id})
+	} else {
+		request = request.DeviceTypeId([]*int32{&parent.id})
+	}
+
+	resources, response, err := util.ListAll[netbox.ConsolePortTemplate,
+		*netbox.PaginatedConsolePortTemplateList](request)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates := []map[string]any{}
+	for _, r := range resources {
+		t := r.GetType()
+		templates = append(templates, map[string]any{
+			"description": r.GetDescription(),
+			"id":          int(r.GetId()),
+			"label":       r.GetLabel(),
+			"name":        r.GetName(),
+			"type":        string(t.GetValue()),
+		})
+	}
+
+	return templates, nil
+}
+
+func writeConsolePortTemplate(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, id int32, e map[string]any) diag.Diagnostics {
+
+	request := netbox.NewWritableConsolePortTemplateRequest(
+		e["name"].(string))
+	if parent.module {
+		request.SetModuleType(*parent.moduleType)
+	} else {
+		request.SetDeviceType(*parent.deviceType)
+	}
+	request.SetDescription(e["description"].(string))
+	request.SetLabel(e["label"].(string))
+
+	t, err := netbox.NewConsolePortTypeValueFromValue(e["type"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	request.SetType(*t)
+
+	var response *http.Response
+	if id == 0 {
+		_, response, err = client.DcimAPI.DcimConsolePortTemplatesCreate(
+			ctx).WritableConsolePortTemplateRequest(*request).Execute()
+	} else {
+		_, response, err = client.DcimAPI.DcimConsolePortTemplatesUpdate(ctx,
+			id).WritableConsolePortTemplateRequest(*request).Execute()
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func destroyConsolePortTemplate(ctx context.Context,
+	client *netbox.APIClient, id int32) diag.Diagnostics {
+
+	if response, err := client.DcimAPI.DcimConsolePortTemplatesDestroy(ctx,
+		id).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func listFrontPortTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent) ([]map[string]any, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimFrontPortTemplatesList(ctx).Limit(
+		util.Const1000)
+	if parent.module {
+		request = request.ModuleTypeId([]*int32{&parent.id})
+	} else {
+		request = request.DeviceTypeId([]*int32{&parent.id})
+	}
+
+	resources, response, err := util.ListAll[netbox.FrontPortTemplate,
+		*netbox.PaginatedFrontPortTemplateList](request)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates := []map[string]any{}
+	for _, r := range resources {
+		templates = append(templates, map[string]any{
+			"color":              r.GetColor(),
+			"description":        r.GetDescription(),
+			"id":                 int(r.GetId()),
+			"label":              r.GetLabel(),
+			"name":               r.GetName(),
+			"rear_port":          r.RearPort.GetName(),
+			"rear_port_position": int(r.GetRearPortPosition()),
+			"type":               string(r.Type.GetValue()),
+		})
+	}
+
+	return templates, nil
+}
+
+func writeFrontPortTemplate(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, id int32, e map[string]any) diag.Diagnostics {
+
+	rearPorts, errDiag := listRearPortTemplates(ctx, client, parent)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	rearPortID := 0
+	for _, r := range rearPorts {
+		if r["name"] == e["rear_port"] {
+			rearPortID = r["id"].(int)
+		}
+	}
+
+	if rearPortID == 0 {
+		return util.GenerateErrorMessage(nil,
+			fmt.Errorf("Rear port template %s not found",
+				e["rear_port"].(string)))
+	}
+
+	rearPort, errDiag := brief.GetBriefRearPortTemplateRequestFromID(ctx,
+		client, rearPortID)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	t, err := netbox.NewFrontPortTypeValueFromValue(e["type"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	request := netbox.NewWritableFrontPortTemplateRequest(e["name"].(string),
+		*t, *rearPort)
+	if parent.module {
+		request.SetModuleType(*parent.moduleType)
+	} else {
+		request.SetDeviceType(*parent.deviceType)
+	}
+	request.SetColor(e["color"].(string))
+	request.SetDescription(e["description"].(string))
+	request.SetLabel(e["label"].(string))
+
+	position, err := safecast.ToInt32(e["rear_port_position"].(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	request.SetRearPortPosition(position)
+
+	var response *http.Response
+	if id == 0 {
+		_, response, err = client.DcimAPI.DcimFrontPortTemplatesCreate(
+			ctx).WritableFrontPortTemplateRequest(*request).Execute()
+	} else {
+		_, response, err = client.DcimAPI.DcimFrontPortTemplatesUpdate(ctx,
+			id).WritableFrontPortTemplateRequest(*request).Execute()
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func destroyFrontPortTemplate(ctx context.Context, client *netbox.APIClient,
+	id int32) diag.Diagnostics {
+
+	if response, err := client.DcimAPI.DcimFrontPortTemplatesDestroy(ctx,
+		id).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func listInterfaceTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent) ([]map[string]any, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimInterfaceTemplatesList(ctx).Limit(
+		util.Const1000)
+	if parent.module {
+		request = request.ModuleTypeId([]*int32{&parent.id})
+	} else {
+		request = request.DeviceTypeId([]*int32{&parent.id})
+	}
+
+	resources, response, err := util.ListAll[netbox.InterfaceTemplate,
+		*netbox.PaginatedInterfaceTemplateList](request)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates := []map[string]any{}
+	for _, r := range resources {
+		poeMode := r.GetPoeMode()
+		poeType := r.GetPoeType()
+		templates = append(templates, map[string]any{
+			"description": r.GetDescription(),
+			"enabled":     r.GetEnabled(),
+			"id":          int(r.GetId()),
+			"label":       r.GetLabel(),
+			"mgmt_only":   r.GetMgmtOnly(),
+			"name":        r.GetName(),
+			"poe_mode":    string(poeMode.GetValue()),
+			"poe_type":    string(poeType.GetValue()),
+			"type":        string(r.Type.GetValue()),
+		})
+	}
+
+	return templates, nil
+}
+
+func writeInterfaceTemplate(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, id int32, e map[string]any) diag.Diagnostics {
+
+	t, err := netbox.NewInterfaceTypeValueFromValue(e["type"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	request := netbox.NewWritableInterfaceTemplateRequest(e["name"].(string),
+		*t)
+	if parent.module {
+		request.SetModuleType(*parent.moduleType)
+	} else {
+		request.SetDeviceType(*parent.deviceType)
+	}
+	request.SetDescription(e["description"].(string))
+	request.SetEnabled(e["enabled"].(bool))
+	request.SetLabel(e["label"].(string))
+	request.SetMgmtOnly(e["mgmt_only"].(bool))
+
+	poeMode, err := netbox.NewInterfacePoeModeValueFromValue(
+		e["poe_mode"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	request.SetPoeMode(*poeMode)
+
+	poeType, err := netbox.NewInterfacePoeTypeValueFromValue(
+		e["poe_type"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	request.SetPoeType(*poeType)
+
+	var response *http.Response
+	if id == 0 {
+		_, response, err = client.DcimAPI.DcimInterfaceTemplatesCreate(
+			ctx).WritableInterfaceTemplateRequest(*request).Execute()
+	} else {
+		_, response, err = client.DcimAPI.DcimInterfaceTemplatesUpdate(ctx,
+			id).WritableInterfaceTemplateRequest(*request).Execute()
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func destroyInterfaceTemplate(ctx context.Context, client *netbox.APIClient,
+	id int32) diag.Diagnostics {
+
+	if response, err := client.DcimAPI.DcimInterfaceTemplatesDestroy(ctx,
+		id).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func listModuleBayTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent) ([]map[string]any, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimModuleBayTemplatesList(ctx).Limit(
+		util.Const1000).DeviceTypeId([]int32{parent.id})
+
+	resources, response, err := util.ListAll[netbox.ModuleBayTemplate,
+		*netbox.PaginatedModuleBayTemplateList](request)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates := []map[string]any{}
+	for _, r := range resources {
+		templates = append(templates, map[string]any{
+			"description": r.GetDescription(),
+			"id":          int(r.GetId()),
+			"label":       r.GetLabel(),
+			"name":        r.GetName(),
+			"position":    r.GetPosition(),
+		})
+	}
+
+	return templates, nil
+}
+
+func writeModuleBayTemplate(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, id int32, e map[string]any) diag.Diagnostics {
+
+	request := netbox.NewModuleBayTemplateRequest(*parent.deviceType,
+		e["name"].(string))
+	request.SetDescription(e["description"].(string))
+	request.SetLabel(e["label"].(string))
+	request.SetPosition(e["position"].(string))
+
+	var response *http.Response
+	var err error
+	if id == 0 {
+		_, response, err = client.DcimAPI.DcimModuleBayTemplatesCreate(
+			ctx).ModuleBayTemplateRequest(*request).Execute()
+	} else {
+		_, response, err = client.DcimAPI.DcimModuleBayTemplatesUpdate(ctx,
+			id).ModuleBayTemplateRequest(*request).Execute()
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func destroyModuleBayTemplate(ctx context.Context, client *netbox.APIClient,
+	id int32) diag.Diagnostics {
+
+	if response, err := client.DcimAPI.DcimModuleBayTemplatesDestroy(ctx,
+		id).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func listPowerPortTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent) ([]map[string]any, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimPowerPortTemplatesList(ctx).Limit(
+		util.Const1000)
+	if parent.module {
+		request = request.ModuleTypeId([]*int32{&parent.id})
+	} else {
+		request = request.DeviceTypeId([]*int32{&parent.id})
+	}
+
+	resources, response, err := util.ListAll[netbox.PowerPortTemplate,
+		*netbox.PaginatedPowerPortTemplateList](request)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates := []map[string]any{}
+	for _, r := range resources {
+		t := r.GetType()
+		templates = append(templates, map[string]any{
+			"allocated_draw": int(r.GetAllocatedDraw()),
+			"description":    r.GetDescription(),
+			"id":             int(r.GetId()),
+			"label":          r.GetLabel(),
+			"maximum_draw":   int(r.GetMaximumDraw()),
+			"name":           r.GetName(),
+			"type":           string(t.GetValue()),
+		})
+	}
+
+	return templates, nil
+}
+
+func writePowerPortTemplate(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, id int32, e map[string]any) diag.Diagnostics {
+
+	request := netbox.NewWritablePowerPortTemplateRequest(e["name"].(string))
+	if parent.module {
+		request.SetModuleType(*parent.moduleType)
+	} else {
+		request.SetDeviceType(*parent.deviceType)
+	}
+	request.SetDescription(e["description"].(string))
+	request.SetLabel(e["label"].(string))
+
+	if allocatedDraw := e["allocated_draw"].(int); allocatedDraw != 0 {
+		allocatedDraw32, err := safecast.ToInt32(allocatedDraw)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		request.SetAllocatedDraw(allocatedDraw32)
+	} else {
+		request.SetAllocatedDrawNil()
+	}
+
+	if maximumDraw := e["maximum_draw"].(int); maximumDraw != 0 {
+		maximumDraw32, err := safecast.ToInt32(maximumDraw)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		request.SetMaximumDraw(maximumDraw32)
+	} else {
+		request.SetMaximumDrawNil()
+	}
+
+	t, err := netbox.NewPatchedWritablePowerPortTemplateRequestTypeFromValue(
+		e["type"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	request.SetType(*t)
+
+	var response *http.Response
+	if id == 0 {
+		_, response, err = client.DcimAPI.DcimPowerPortTemplatesCreate(
+			ctx).WritablePowerPortTemplateRequest(*request).Execute()
+	} else {
+		_, response, err = client.DcimAPI.DcimPowerPortTemplatesUpdate(ctx,
+			id).WritablePowerPortTemplateRequest(*request).Execute()
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func destroyPowerPortTemplate(ctx context.Context, client *netbox.APIClient,
+	id int32) diag.Diagnostics {
+
+	if response, err := client.DcimAPI.DcimPowerPortTemplatesDestroy(ctx,
+		id).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func listRearPortTemplates(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent) ([]map[string]any, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimRearPortTemplatesList(ctx).Limit(
+		util.Const1000)
+	if parent.module {
+		request = request.ModuleTypeId([]*int32{&parent.id})
+	} else {
+		request = request.DeviceTypeId([]*int32{&parent.id})
+	}
+
+	resources, response, err := util.ListAll[netbox.RearPortTemplate,
+		*netbox.PaginatedRearPortTemplateList](request)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates := []map[string]any{}
+	for _, r := range resources {
+		templates = append(templates, map[string]any{
+			"color":       r.GetColor(),
+			"description": r.GetDescription(),
+			"id":          int(r.GetId()),
+			"label":       r.GetLabel(),
+			"name":        r.GetName(),
+			"positions":   int(r.GetPositions()),
+			"type":        string(r.Type.GetValue()),
+		})
+	}
+
+	return templates, nil
+}
+
+func writeRearPortTemplate(ctx context.Context, client *netbox.APIClient,
+	parent *templateParent, id int32, e map[string]any) diag.Diagnostics {
+
+	t, err := netbox.NewFrontPortTypeValueFromValue(e["type"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	request := netbox.NewWritableRearPortTemplateRequest(e["name"].(string),
+		*t)
+	if parent.module {
+		request.SetModuleType(*parent.moduleType)
+	} else {
+		request.SetDeviceType(*parent.deviceType)
+	}
+	request.SetColor(e["color"].(string))
+	request.SetDescription(e["description"].(string))
+	request.SetLabel(e["label"].(string))
+
+	positions, err := safecast.ToInt32(e["positions"].(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	request.SetPositions(positions)
+
+	var response *http.Response
+	if id == 0 {
+		_, response, err = client.DcimAPI.DcimRearPortTemplatesCreate(
+			ctx).WritableRearPortTemplateRequest(*request).Execute()
+	} else {
+		_, response, err = client.DcimAPI.DcimRearPortTemplatesUpdate(ctx,
+			id).WritableRearPortTemplateRequest(*request).Execute()
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func destroyRearPortTemplate(ctx context.Context, client *netbox.APIClient,
+	id int32) diag.Diagnostics {
+
+	if response, err := client.DcimAPI.DcimRearPortTemplatesDestroy(ctx,
+		id).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
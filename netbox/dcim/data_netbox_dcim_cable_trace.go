@@ -0,0 +1,310 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+// Object types which can be traced, the pass-through ones (front ports, rear
+// ports and circuit terminations) may have several paths.
+var cableTraceEndpointTypes = []string{
+	"circuits.circuittermination",
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.powerfeed",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+func cableTraceEndSchema(end string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The terminations of the " + end + " of this hop.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"device_id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+					Description: "ID of the device of this termination " +
+						"if any.",
+				},
+				"device_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+					Description: "Name of the device of this termination " +
+						"if any.",
+				},
+				"display": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The display name of this termination.",
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+					Description: "The name of this termination, the display " +
+						"name is used if the termination has no name.",
+				},
+				"object_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The ID of the object of this termination.",
+				},
+				"object_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the object of this termination.",
+				},
+			},
+		},
+	}
+}
+
+func DataNetboxDcimCableTrace() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the cable path traced from an endpoint " +
+			"from netbox.",
+		ReadContext: dataNetboxDcimCableTraceRead,
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the endpoint to trace.",
+			},
+			"endpoint_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					cableTraceEndpointTypes, false),
+				Description: "The type of the endpoint to trace " +
+					"(dcim.interface, dcim.frontport, ...).",
+			},
+			"hop": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The ordered hops of the traced paths, " +
+					"starting from the endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cable_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Description: "ID of the cable of this hop, 0 if " +
+								"the path is not connected.",
+						},
+						"far_end":  cableTraceEndSchema("far end"),
+						"near_end": cableTraceEndSchema("near end"),
+						"path": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Description: "Index of the path of this hop, " +
+								"always 0 except for the pass-through " +
+								"endpoints which may have several paths.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataNetboxDcimCableTraceRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	endpointType := d.Get("endpoint_type").(string)
+	endpointID, err := safecast.ToInt32(d.Get("endpoint_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	// The generated clients do not describe the responses of the trace
+	// endpoints, the raw response is decoded instead.
+	var response *http.Response
+	switch endpointType {
+	case "circuits.circuittermination":
+		_, response, err = client.CircuitsAPI.
+			CircuitsCircuitTerminationsPathsRetrieve(ctx,
+				endpointID).Execute()
+	case "dcim.consoleport":
+		_, response, err = client.DcimAPI.DcimConsolePortsTraceRetrieve(ctx,
+			endpointID).Execute()
+	case "dcim.consoleserverport":
+		_, response, err = client.DcimAPI.DcimConsoleServerPortsTraceRetrieve(
+			ctx, endpointID).Execute()
+	case "dcim.frontport":
+		_, response, err = client.DcimAPI.DcimFrontPortsPathsRetrieve(ctx,
+			endpointID).Execute()
+	case "dcim.interface":
+		_, response, err = client.DcimAPI.DcimInterfacesTraceRetrieve(ctx,
+			endpointID).Execute()
+	case "dcim.powerfeed":
+		_, response, err = client.DcimAPI.DcimPowerFeedsTraceRetrieve(ctx,
+			endpointID).Execute()
+	case "dcim.poweroutlet":
+		_, response, err = client.DcimAPI.DcimPowerOutletsTraceRetrieve(ctx,
+			endpointID).Execute()
+	case "dcim.powerport":
+		_, response, err = client.DcimAPI.DcimPowerPortsTraceRetrieve(ctx,
+			endpointID).Execute()
+	case "dcim.rearport":
+		_, response, err = client.DcimAPI.DcimRearPortsPathsRetrieve(ctx,
+			endpointID).Execute()
+	}
+
+	if response == nil || response.StatusCode != util.Const200 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	paths, err := decodeCablePaths(body)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", endpointType, endpointID))
+	if err = d.Set("hop", flattenCableTraceHops(paths)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+// decodeCablePaths returns the nodes of the paths described by the response
+// of a trace (list of [near end, cable, far end] segments) or of a paths
+// endpoint (list of cable paths).
+func decodeCablePaths(body []byte) ([][]any, error) {
+	var segments []any
+	if err := json.Unmarshal(body, &segments); err != nil {
+		return nil, err
+	}
+
+	var paths [][]any
+	var trace []any
+	for _, s := range segments {
+		switch segment := s.(type) {
+		case []any:
+			trace = append(trace, segment...)
+		case map[string]any:
+			if path, ok := segment["path"].([]any); ok {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	if len(trace) > 0 {
+		paths = append(paths, trace)
+	}
+
+	return paths, nil
+}
+
+// flattenCableTraceHops splits the paths into hops made of a near end, a
+// cable and a far end.
+func flattenCableTraceHops(paths [][]any) []any {
+	hops := []any{}
+	for i, path := range paths {
+		for j := 0; j < len(path); j += 3 {
+			hop := map[string]any{
+				"cable_id": 0,
+				"far_end":  []any{},
+				"near_end": flattenCableTraceNodes(path[j]),
+				"path":     i,
+			}
+
+			if j+1 < len(path) {
+				for _, cable := range cableTraceNodes(path[j+1]) {
+					hop["cable_id"] = cableTraceInt(cable["id"])
+				}
+			}
+
+			if j+2 < len(path) {
+				hop["far_end"] = flattenCableTraceNodes(path[j+2])
+			}
+
+			hops = append(hops, hop)
+		}
+	}
+
+	return hops
+}
+
+// cableTraceNodes returns the objects of a node of a path which is either
+// a single object, a list of objects or null.
+func cableTraceNodes(node any) []map[string]any {
+	var objects []map[string]any
+	switch n := node.(type) {
+	case map[string]any:
+		objects = append(objects, n)
+	case []any:
+		for _, o := range n {
+			if object, ok := o.(map[string]any); ok {
+				objects = append(objects, object)
+			}
+		}
+	}
+
+	return objects
+}
+
+func flattenCableTraceNodes(node any) []any {
+	ends := []any{}
+	for _, object := range cableTraceNodes(node) {
+		display, _ := object["display"].(string)
+		name, ok := object["name"].(string)
+		if !ok || name == "" {
+			name = display
+		}
+
+		objectType := ""
+		if url, ok := object["url"].(string); ok {
+			objectType = util.ConvertURLContentType(url)
+		}
+
+		end := map[string]any{
+			"device_id":   0,
+			"device_name": "",
+			"display":     display,
+			"name":        name,
+			"object_id":   cableTraceInt(object["id"]),
+			"object_type": objectType,
+		}
+
+		if device, ok := object["device"].(map[string]any); ok {
+			end["device_id"] = cableTraceInt(device["id"])
+			end["device_name"], _ = device["name"].(string)
+		}
+
+		ends = append(ends, end)
+	}
+
+	return ends
+}
+
+func cableTraceInt(v any) int {
+	f, _ := v.(float64)
+	return int(f)
+}
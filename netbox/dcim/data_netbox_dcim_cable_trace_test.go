@@ -0,0 +1,223 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Response of /api/dcim/interfaces/{id}/trace/, the last segment is not
+// connected
+const testCableTraceResponse = `[
+  [
+    [{"id": 1, "url": "http://netbox/api/dcim/interfaces/1/",
+      "display": "eth0", "name": "eth0",
+      "device": {"id": 10, "name": "switch1"}}],
+    {"id": 5, "url": "http://netbox/api/dcim/cables/5/", "display": "#5"},
+    [{"id": 2, "url": "http://netbox/api/dcim/front-ports/2/",
+      "display": "fp1", "name": "fp1",
+      "device": {"id": 11, "name": "patchpanel1"}}]
+  ],
+  [
+    [{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/",
+      "display": "rp1", "name": "rp1",
+      "device": {"id": 11, "name": "patchpanel1"}}],
+    null,
+    null
+  ]
+]`
+
+// Response of /api/dcim/rear-ports/{id}/paths/, the second path is not
+// connected
+const testCablePathsResponse = `[
+  {
+    "id": 7,
+    "is_active": true,
+    "path": [
+      [{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/",
+        "display": "rp1", "name": "rp1"}],
+      [{"id": 6, "url": "http://netbox/api/dcim/cables/6/", "display": "#6"}],
+      [{"id": 4, "url": "http://netbox/api/circuits/circuit-terminations/4/",
+        "display": "Termination A"}]
+    ]
+  },
+  {
+    "id": 8,
+    "is_active": false,
+    "path": [
+      [{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/",
+        "display": "rp1", "name": "rp1"}],
+      null,
+      null
+    ]
+  }
+]`
+
+func TestDecodeCablePathsTrace(t *testing.T) {
+	paths, err := decodeCablePaths([]byte(testCableTraceResponse))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The segments of a trace are joined into a single path
+	if len(paths) != 1 {
+		t.Fatalf("%d paths, want 1", len(paths))
+	}
+
+	if len(paths[0]) != 6 {
+		t.Fatalf("%d nodes, want 6", len(paths[0]))
+	}
+
+	if paths[0][4] != nil || paths[0][5] != nil {
+		t.Errorf("unconnected nodes = %v, %v, want nil", paths[0][4],
+			paths[0][5])
+	}
+}
+
+func TestDecodeCablePathsPaths(t *testing.T) {
+	paths, err := decodeCablePaths([]byte(testCablePathsResponse))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("%d paths, want 2", len(paths))
+	}
+
+	for i, path := range paths {
+		if len(path) != 3 {
+			t.Errorf("path %d: %d nodes, want 3", i, len(path))
+		}
+	}
+}
+
+func TestDecodeCablePathsInvalid(t *testing.T) {
+	body := []byte(`{"detail": "Not found."}`)
+	if _, err := decodeCablePaths(body); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestCableTraceNodes(t *testing.T) {
+	object := map[string]any{"id": 1.0}
+
+	cases := map[string]struct {
+		node any
+		want int
+	}{
+		"null":         {node: nil, want: 0},
+		"single":       {node: object, want: 1},
+		"list":         {node: []any{object, object}, want: 2},
+		"empty list":   {node: []any{}, want: 0},
+		"list of junk": {node: []any{"x", nil, object}, want: 1},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := cableTraceNodes(c.node); len(got) != c.want {
+				t.Errorf("%d objects, want %d", len(got), c.want)
+			}
+		})
+	}
+}
+
+func TestFlattenCableTraceNodes(t *testing.T) {
+	node := []any{
+		map[string]any{
+			"id":      2.0,
+			"url":     "http://netbox/api/dcim/front-ports/2/",
+			"display": "fp1",
+			"name":    "fp1",
+			"device":  map[string]any{"id": 11.0, "name": "patchpanel1"},
+		},
+		map[string]any{
+			"id":      4.0,
+			"url":     "http://netbox/api/circuits/circuit-terminations/4/",
+			"display": "Termination A",
+		},
+	}
+
+	want := []any{
+		map[string]any{
+			"device_id":   11,
+			"device_name": "patchpanel1",
+			"display":     "fp1",
+			"name":        "fp1",
+			"object_id":   2,
+			"object_type": "dcim.frontport",
+		},
+		map[string]any{
+			"device_id":   0,
+			"device_name": "",
+			"display":     "Termination A",
+			"name":        "Termination A",
+			"object_id":   4,
+			"object_type": "circuits.circuittermination",
+		},
+	}
+
+	if got := flattenCableTraceNodes(node); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := flattenCableTraceNodes(nil); len(got) != 0 {
+		t.Errorf("got %v for a null node, want nothing", got)
+	}
+}
+
+func TestFlattenCableTraceHops(t *testing.T) {
+	cases := map[string]struct {
+		response string
+		cables   []int
+		paths    []int
+		farEnds  []int
+	}{
+		"trace": {
+			response: testCableTraceResponse,
+			cables:   []int{5, 0},
+			paths:    []int{0, 0},
+			farEnds:  []int{1, 0},
+		},
+		"paths": {
+			response: testCablePathsResponse,
+			cables:   []int{6, 0},
+			paths:    []int{0, 1},
+			farEnds:  []int{1, 0},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			paths, err := decodeCablePaths([]byte(c.response))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			hops := flattenCableTraceHops(paths)
+			if len(hops) != len(c.cables) {
+				t.Fatalf("%d hops, want %d", len(hops), len(c.cables))
+			}
+
+			for i, h := range hops {
+				hop := h.(map[string]any)
+				if hop["cable_id"] != c.cables[i] {
+					t.Errorf("hop %d: cable_id = %v, want %d", i,
+						hop["cable_id"], c.cables[i])
+				}
+				if hop["path"] != c.paths[i] {
+					t.Errorf("hop %d: path = %v, want %d", i, hop["path"],
+						c.paths[i])
+				}
+				if n := len(hop["near_end"].([]any)); n != 1 {
+					t.Errorf("hop %d: %d near ends, want 1", i, n)
+				}
+				if n := len(hop["far_end"].([]any)); n != c.farEnds[i] {
+					t.Errorf("hop %d: %d far ends, want %d", i, n,
+						c.farEnds[i])
+				}
+			}
+		})
+	}
+}
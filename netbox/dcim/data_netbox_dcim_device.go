@@ -0,0 +1,88 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxDcimDevice() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about device from netbox.",
+		ReadContext: dataNetboxDcimDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this device.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of the device.",
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the site where this device " +
+					"is located.",
+			},
+		},
+	}
+}
+
+func dataNetboxDcimDeviceRead(ctx context.Context, d *schema.ResourceData,
+	m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	name := []string{d.Get("name").(string)}
+
+	request := client.DcimAPI.DcimDevicesList(ctx).Name(name)
+
+	if siteID := d.Get("site_id").(int); siteID != 0 {
+		siteID32, err := safecast.ToInt32(siteID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		request = request.SiteId([]int32{siteID32})
+	}
+
+	resource, response, err := request.Execute()
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if resource.GetCount() < 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned no results. "+
+				"Please change your search criteria and try again."))
+
+	} else if resource.GetCount() > 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned more than one result. "+
+				"Please try a more specific search criteria."))
+	}
+
+	r := resource.Results[0]
+	d.SetId(fmt.Sprintf("%d", r.GetId()))
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(r.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
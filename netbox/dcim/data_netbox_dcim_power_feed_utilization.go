@@ -0,0 +1,191 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+// Factor applied by Netbox to compute the power of a three-phase feed
+const powerFeedThreePhaseFactor = 1.732
+
+func DataNetboxDcimPowerFeedUtilization() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the power allocated on a power feed from netbox. " +
+			"The draw of a power port connected to the feed is the one " +
+			"defined on the port or, if none, the sum of the draws of the " +
+			"power ports connected to its power outlets.",
+		ReadContext: dataNetboxDcimPowerFeedUtilizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"allocated_draw": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The allocated draw (W) on this power feed.",
+			},
+			"allocated_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+				Description: "The allocated draw in percentage of the " +
+					"available power of this power feed.",
+			},
+			"available_draw": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The draw (W) which can still be allocated on " +
+					"this power feed.",
+			},
+			"available_power": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The available power (W) of this power feed " +
+					"according to its voltage, amperage, phase and " +
+					"maximum utilization.",
+			},
+			"maximum_draw": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum draw (W) on this power feed.",
+			},
+			"power_feed_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the power feed.",
+			},
+		},
+	}
+}
+
+func dataNetboxDcimPowerFeedUtilizationRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	powerFeedID, err := safecast.ToInt32(d.Get("power_feed_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	feed, response, err := client.DcimAPI.DcimPowerFeedsRetrieve(ctx,
+		powerFeedID).Execute()
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	available := math.Abs(float64(feed.GetVoltage())) *
+		float64(feed.GetAmperage()) *
+		float64(feed.GetMaxUtilization()) / util.Const100
+	phase := feed.GetPhase()
+	if phase.GetValue() ==
+		netbox.PATCHEDWRITABLEPOWERFEEDREQUESTPHASE_THREE_PHASE {
+		available *= powerFeedThreePhaseFactor
+	}
+	availablePower := int(math.Round(available))
+
+	allocatedDraw := 0
+	maximumDraw := 0
+	for _, id := range connectedEndpointIDs(feed.GetConnectedEndpoints()) {
+		allocated, maximum, errDiag := getPowerPortDraw(ctx, client, id)
+		if errDiag != nil {
+			return errDiag
+		}
+		allocatedDraw += allocated
+		maximumDraw += maximum
+	}
+
+	allocatedPercent := 0.0
+	if availablePower > 0 {
+		allocatedPercent = float64(allocatedDraw) * util.Const100 /
+			float64(availablePower)
+	}
+
+	d.SetId(fmt.Sprintf("%d", powerFeedID))
+
+	if err = d.Set("allocated_draw", allocatedDraw); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("allocated_percent", allocatedPercent); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("available_draw",
+		availablePower-allocatedDraw); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("available_power", availablePower); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("maximum_draw", maximumDraw); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+// getPowerPortDraw returns the allocated and maximum draw of a power port,
+// the draws of the power ports connected to its outlets are used when none
+// is defined on the port.
+func getPowerPortDraw(ctx context.Context, client *netbox.APIClient,
+	id int32) (int, int, diag.Diagnostics) {
+
+	port, response, err := client.DcimAPI.DcimPowerPortsRetrieve(ctx,
+		id).Execute()
+	if err != nil {
+		return 0, 0, util.GenerateErrorMessage(response, err)
+	}
+
+	if port.GetAllocatedDraw() != 0 || port.GetMaximumDraw() != 0 {
+		return int(port.GetAllocatedDraw()), int(port.GetMaximumDraw()), nil
+	}
+
+	request := client.DcimAPI.DcimPowerOutletsList(ctx).PowerPortId(
+		[]*int32{&id}).Limit(util.Const1000)
+	outlets, response, err := util.ListAll[netbox.PowerOutlet,
+		*netbox.PaginatedPowerOutletList](request)
+	if err != nil {
+		return 0, 0, util.GenerateErrorMessage(response, err)
+	}
+
+	allocatedDraw := 0
+	maximumDraw := 0
+	for _, outlet := range outlets {
+		for _, portID := range connectedEndpointIDs(
+			outlet.GetConnectedEndpoints()) {
+
+			downstream, response, err := client.DcimAPI.DcimPowerPortsRetrieve(
+				ctx, portID).Execute()
+			if err != nil {
+				return 0, 0, util.GenerateErrorMessage(response, err)
+			}
+			allocatedDraw += int(downstream.GetAllocatedDraw())
+			maximumDraw += int(downstream.GetMaximumDraw())
+		}
+	}
+
+	return allocatedDraw, maximumDraw, nil
+}
+
+func connectedEndpointIDs(endpoints []any) []int32 {
+	var ids []int32
+	for _, e := range endpoints {
+		if endpoint, ok := e.(map[string]any); ok {
+			if id, ok := endpoint["id"].(float64); ok {
+				ids = append(ids, int32(id))
+			}
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,180 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxDcimRackElevation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the elevation of a face of a rack from netbox.",
+		ReadContext: dataNetboxDcimRackElevationRead,
+
+		Schema: map[string]*schema.Schema{
+			"available_positions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The positions, from the lowest to the highest, " +
+					"where a device of u_height units can be installed.",
+			},
+			"face": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "front",
+				ValidateFunc: validation.StringInSlice(rackFaces, false),
+				Description: "The face of the rack (front or rear, " +
+					"front by default).",
+			},
+			"free_range": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The ranges of contiguous free units which can " +
+					"hold a device of u_height units.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_unit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The lowest unit of this range.",
+						},
+						"last_unit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The highest unit of this range.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of units of this range.",
+						},
+					},
+				},
+			},
+			"rack_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the rack.",
+			},
+			"u_height": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "The height in units used to compute the free " +
+					"ranges and the available positions (1 by default).",
+			},
+			"unit": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The units of this face of the rack, from the " +
+					"lowest to the highest.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Description: "ID of the device occupying this " +
+								"unit if any.",
+						},
+						"device_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "Name of the device occupying this " +
+								"unit if any.",
+						},
+						"face": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The face of this unit.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of this unit.",
+						},
+						"occupied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+							Description: "Is this unit occupied (by a device " +
+								"of this face or a full depth device)?",
+						},
+						"position": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The number of this unit.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataNetboxDcimRackElevationRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	face := d.Get("face").(string)
+	height := d.Get("u_height").(int)
+	rackID, err := safecast.ToInt32(d.Get("rack_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	units, errDiag := getRackElevation(ctx, client, rackID, face, 0)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	unitList := []any{}
+	for _, u := range units {
+		unitList = append(unitList, map[string]any{
+			"device_id":   u.DeviceID,
+			"device_name": u.DeviceName,
+			"face":        u.Face,
+			"name":        u.Name,
+			"occupied":    u.Occupied,
+			"position":    u.Unit,
+		})
+	}
+
+	freeRanges := []any{}
+	for _, r := range getRackFreeRanges(height, units) {
+		freeRanges = append(freeRanges, map[string]any{
+			"first_unit": r.First,
+			"last_unit":  r.Last,
+			"size":       r.Last - r.First + 1,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d:%s", rackID, face))
+
+	if err = d.Set("available_positions",
+		getRackAvailablePositions(height, units)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("free_range", freeRanges); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("unit", unitList); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxDcimVirtualDeviceContext() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about virtual device context of a device " +
+			"from netbox.",
+		ReadContext: dataNetboxDcimVirtualDeviceContextRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The content type of this virtual device " +
+					"context.",
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the device of the virtual device " +
+					"context.",
+			},
+			"identifier": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The numeric identifier of the virtual device " +
+					"context.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of the virtual device context.",
+			},
+		},
+	}
+}
+
+func dataNetboxDcimVirtualDeviceContextRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	deviceID, err := safecast.ToInt32(d.Get("device_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	name := []string{d.Get("name").(string)}
+
+	resource, response, err := client.DcimAPI.DcimVirtualDeviceContextsList(
+		ctx).DeviceId([]int32{deviceID}).Name(name).Execute()
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if resource.GetCount() < 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned no results. "+
+				"Please change your search criteria and try again."))
+
+	} else if resource.GetCount() > 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned more than one result. "+
+				"Please try a more specific search criteria."))
+	}
+
+	r := resource.Results[0]
+	d.SetId(fmt.Sprintf("%d", r.GetId()))
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(r.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("identifier", r.GetIdentifier()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,464 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	netbox "github.com/smutel/go-netbox/v4"
+	"gopkg.in/yaml.v3"
+)
+
+// The devicetype-library format is the YAML format used by the community
+// repository https://github.com/netbox-community/devicetype-library to
+// describe device types and their components.
+
+type libraryConsolePort struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Description string `yaml:"description"`
+}
+
+type libraryFrontPort struct {
+	Name             string `yaml:"name"`
+	Label            string `yaml:"label"`
+	Type             string `yaml:"type"`
+	Color            string `yaml:"color"`
+	RearPort         string `yaml:"rear_port"`
+	RearPortPosition *int   `yaml:"rear_port_position"`
+	Description      string `yaml:"description"`
+}
+
+type libraryInterface struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Enabled     *bool  `yaml:"enabled"`
+	MgmtOnly    bool   `yaml:"mgmt_only"`
+	PoeMode     string `yaml:"poe_mode"`
+	PoeType     string `yaml:"poe_type"`
+	Description string `yaml:"description"`
+}
+
+type libraryModuleBay struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Position    string `yaml:"position"`
+	Description string `yaml:"description"`
+}
+
+type libraryPowerPort struct {
+	Name          string `yaml:"name"`
+	Label         string `yaml:"label"`
+	Type          string `yaml:"type"`
+	MaximumDraw   int    `yaml:"maximum_draw"`
+	AllocatedDraw int    `yaml:"allocated_draw"`
+	Description   string `yaml:"description"`
+}
+
+type libraryRearPort struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Color       string `yaml:"color"`
+	Positions   *int   `yaml:"positions"`
+	Description string `yaml:"description"`
+}
+
+type libraryDeviceType struct {
+	Manufacturer           string               `yaml:"manufacturer"`
+	Model                  string               `yaml:"model"`
+	Slug                   string               `yaml:"slug"`
+	PartNumber             string               `yaml:"part_number"`
+	UHeight                *float64             `yaml:"u_height"`
+	IsFullDepth            *bool                `yaml:"is_full_depth"`
+	ExcludeFromUtilization bool                 `yaml:"exclude_from_utilization"`
+	SubdeviceRole          string               `yaml:"subdevice_role"`
+	Airflow                string               `yaml:"airflow"`
+	Weight                 *float64             `yaml:"weight"`
+	WeightUnit             string               `yaml:"weight_unit"`
+	Description            string               `yaml:"description"`
+	Comments               string               `yaml:"comments"`
+	ConsolePorts           []libraryConsolePort `yaml:"console-ports"`
+	FrontPorts             []libraryFrontPort   `yaml:"front-ports"`
+	Interfaces             []libraryInterface   `yaml:"interfaces"`
+	ModuleBays             []libraryModuleBay   `yaml:"module-bays"`
+	PowerPorts             []libraryPowerPort   `yaml:"power-ports"`
+	RearPorts              []libraryRearPort    `yaml:"rear-ports"`
+}
+
+// Keys of the devicetype-library format handled by the provider
+var libraryKeys = []string{
+	"airflow", "comments", "console-ports", "description",
+	"exclude_from_utilization", "front-ports", "interfaces", "is_full_depth",
+	"manufacturer", "model", "module-bays", "part_number", "power-ports",
+	"rear-ports", "slug", "subdevice_role", "u_height", "weight",
+	"weight_unit",
+}
+
+// Sections of the devicetype-library format with the nested template blocks
+// of netbox_dcim_device_type they are converted into
+var librarySections = map[string]string{
+	"console-ports": "console_port_template",
+	"front-ports":   "front_port_template",
+	"interfaces":    "interface_template",
+	"module-bays":   "module_bay_template",
+	"power-ports":   "power_port_template",
+	"rear-ports":    "rear_port_template",
+}
+
+var librarySlugRegexp = regexp.MustCompile("[^a-z0-9_]+")
+
+// parseDeviceTypeLibrary parses and validates a devicetype-library
+// definition. The keys of the definition which are not handled by the
+// provider are returned so that they can be reported to the user.
+func parseDeviceTypeLibrary(definition string) (*libraryDeviceType,
+	[]string, error) {
+
+	var deviceType libraryDeviceType
+	if err := yaml.Unmarshal([]byte(definition), &deviceType); err != nil {
+		return nil, nil, err
+	}
+
+	var keys map[string]any
+	if err := yaml.Unmarshal([]byte(definition), &keys); err != nil {
+		return nil, nil, err
+	}
+
+	var ignoredKeys []string
+	for k := range keys {
+		if !slices.Contains(libraryKeys, k) {
+			ignoredKeys = append(ignoredKeys, k)
+		}
+	}
+	sort.Strings(ignoredKeys)
+
+	if err := deviceType.validate(); err != nil {
+		return nil, ignoredKeys, err
+	}
+
+	return &deviceType, ignoredKeys, nil
+}
+
+//nolint:gocyclo
+func (t *libraryDeviceType) validate() error {
+	var errs []error
+
+	if t.Manufacturer == "" {
+		errs = append(errs, errors.New("manufacturer is required"))
+	}
+
+	if t.Model == "" {
+		errs = append(errs, errors.New("model is required"))
+	}
+
+	if t.Slug == "" {
+		errs = append(errs, errors.New("slug is required"))
+	}
+
+	if t.Airflow != "" {
+		if _, err := netbox.NewDeviceAirflowValueFromValue(
+			t.Airflow); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if t.SubdeviceRole != "" {
+		if _, err := netbox.NewParentChildStatus1FromValue(
+			t.SubdeviceRole); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if t.WeightUnit != "" {
+		if _, err := netbox.NewDeviceTypeWeightUnitValueFromValue(
+			t.WeightUnit); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	names := map[string]bool{}
+	checkName := func(section, name string) {
+		if name == "" {
+			errs = append(errs, fmt.Errorf("%s: name is required", section))
+		} else if names[section+"/"+name] {
+			errs = append(errs, fmt.Errorf("%s: %s is defined twice",
+				section, name))
+		}
+		names[section+"/"+name] = true
+	}
+
+	for _, p := range t.ConsolePorts {
+		checkName("console-ports", p.Name)
+		if _, err := netbox.NewConsolePortTypeValueFromValue(
+			p.Type); err != nil {
+			errs = append(errs, fmt.Errorf("console-ports: %s: %w", p.Name,
+				err))
+		}
+	}
+
+	rearPortPositions := map[string]int{}
+	for _, p := range t.RearPorts {
+		checkName("rear-ports", p.Name)
+		if _, err := netbox.NewFrontPortTypeValueFromValue(p.Type); err != nil {
+			errs = append(errs, fmt.Errorf("rear-ports: %s: %w", p.Name, err))
+		}
+		positions := libraryPosition(p.Positions)
+		if positions < 1 || positions > maxRearPortPositions {
+			errs = append(errs, fmt.Errorf("rear-ports: %s: positions "+
+				"must be between 1 and %d", p.Name, maxRearPortPositions))
+		}
+		rearPortPositions[p.Name] = positions
+	}
+
+	for _, p := range t.FrontPorts {
+		checkName("front-ports", p.Name)
+		if _, err := netbox.NewFrontPortTypeValueFromValue(p.Type); err != nil {
+			errs = append(errs, fmt.Errorf("front-ports: %s: %w", p.Name,
+				err))
+		}
+
+		positions, ok := rearPortPositions[p.RearPort]
+		if !ok {
+			errs = append(errs, fmt.Errorf("front-ports: %s: rear port %q "+
+				"is not defined in rear-ports", p.Name, p.RearPort))
+		} else if position := libraryPosition(
+			p.RearPortPosition); position < 1 || position > positions {
+			errs = append(errs, fmt.Errorf("front-ports: %s: "+
+				"rear_port_position must be between 1 and %d", p.Name,
+				positions))
+		}
+	}
+
+	for _, i := range t.Interfaces {
+		checkName("interfaces", i.Name)
+		if _, err := netbox.NewInterfaceTypeValueFromValue(i.Type); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: %s: %w", i.Name, err))
+		}
+		if _, err := netbox.NewInterfacePoeModeValueFromValue(
+			i.PoeMode); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: %s: %w", i.Name, err))
+		}
+		if _, err := netbox.NewInterfacePoeTypeValueFromValue(
+			i.PoeType); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: %s: %w", i.Name, err))
+		}
+	}
+
+	for _, b := range t.ModuleBays {
+		checkName("module-bays", b.Name)
+	}
+
+	for _, p := range t.PowerPorts {
+		checkName("power-ports", p.Name)
+		if _, err := netbox.NewPatchedWritablePowerPortTemplateRequestTypeFromValue(
+			p.Type); err != nil {
+			errs = append(errs, fmt.Errorf("power-ports: %s: %w", p.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// templates converts the components of the definition into the format of
+// the nested template blocks of netbox_dcim_device_type.
+func (t *libraryDeviceType) templates() map[string][]any {
+	templates := map[string][]any{}
+
+	consolePorts := []any{}
+	for _, p := range t.ConsolePorts {
+		consolePorts = append(consolePorts, map[string]any{
+			"description": p.Description,
+			"label":       p.Label,
+			"name":        p.Name,
+			"type":        p.Type,
+		})
+	}
+	templates["console_port_template"] = consolePorts
+
+	frontPorts := []any{}
+	for _, p := range t.FrontPorts {
+		frontPorts = append(frontPorts, map[string]any{
+			"color":              p.Color,
+			"description":        p.Description,
+			"label":              p.Label,
+			"name":               p.Name,
+			"rear_port":          p.RearPort,
+			"rear_port_position": libraryPosition(p.RearPortPosition),
+			"type":               p.Type,
+		})
+	}
+	templates["front_port_template"] = frontPorts
+
+	interfaces := []any{}
+	for _, i := range t.Interfaces {
+		enabled := true
+		if i.Enabled != nil {
+			enabled = *i.Enabled
+		}
+
+		interfaces = append(interfaces, map[string]any{
+			"description": i.Description,
+			"enabled":     enabled,
+			"label":       i.Label,
+			"mgmt_only":   i.MgmtOnly,
+			"name":        i.Name,
+			"poe_mode":    i.PoeMode,
+			"poe_type":    i.PoeType,
+			"type":        i.Type,
+		})
+	}
+	templates["interface_template"] = interfaces
+
+	moduleBays := []any{}
+	for _, b := range t.ModuleBays {
+		moduleBays = append(moduleBays, map[string]any{
+			"description": b.Description,
+			"label":       b.Label,
+			"name":        b.Name,
+			"position":    b.Position,
+		})
+	}
+	templates["module_bay_template"] = moduleBays
+
+	powerPorts := []any{}
+	for _, p := range t.PowerPorts {
+		powerPorts = append(powerPorts, map[string]any{
+			"allocated_draw": p.AllocatedDraw,
+			"description":    p.Description,
+			"label":          p.Label,
+			"maximum_draw":   p.MaximumDraw,
+			"name":           p.Name,
+			"type":           p.Type,
+		})
+	}
+	templates["power_port_template"] = powerPorts
+
+	rearPorts := []any{}
+	for _, p := range t.RearPorts {
+		rearPorts = append(rearPorts, map[string]any{
+			"color":       p.Color,
+			"description": p.Description,
+			"label":       p.Label,
+			"name":        p.Name,
+			"positions":   libraryPosition(p.Positions),
+			"type":        p.Type,
+		})
+	}
+	templates["rear_port_template"] = rearPorts
+
+	return templates
+}
+
+// normalize returns the definition with the default values of Netbox
+// applied. It is the form used to compare two definitions.
+func (t *libraryDeviceType) normalize() map[string]any {
+	isFullDepth := true
+	if t.IsFullDepth != nil {
+		isFullDepth = *t.IsFullDepth
+	}
+
+	uHeight := 1.0
+	if t.UHeight != nil {
+		uHeight = *t.UHeight
+	}
+
+	definition := map[string]any{
+		"airflow":                  t.Airflow,
+		"comments":                 t.Comments,
+		"description":              t.Description,
+		"exclude_from_utilization": t.ExcludeFromUtilization,
+		"is_full_depth":            isFullDepth,
+		"manufacturer":             t.Manufacturer,
+		"model":                    t.Model,
+		"part_number":              t.PartNumber,
+		"slug":                     t.Slug,
+		"subdevice_role":           t.SubdeviceRole,
+		"u_height":                 uHeight,
+		"weight_unit":              t.WeightUnit,
+	}
+
+	if t.Weight != nil {
+		definition["weight"] = *t.Weight
+	}
+
+	templates := t.templates()
+	for section, key := range librarySections {
+		components := []map[string]any{}
+		for _, elem := range templates[key] {
+			components = append(components, elem.(map[string]any))
+		}
+		setLibraryComponents(definition, section, components)
+	}
+
+	return definition
+}
+
+// setLibraryComponents sets the components of a section of a normalized
+// definition. The components are sorted by name and the empty sections are
+// left out so that the order of the definition does not matter.
+func setLibraryComponents(definition map[string]any, section string,
+	components []map[string]any) {
+
+	if len(components) == 0 {
+		return
+	}
+
+	sorted := []map[string]any{}
+	for _, c := range components {
+		component := map[string]any{}
+		for k, v := range c {
+			if k != "id" {
+				component[k] = v
+			}
+		}
+		sorted = append(sorted, component)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i]["name"].(string) < sorted[j]["name"].(string)
+	})
+
+	definition[section] = sorted
+}
+
+// normalizeDeviceTypeLibrary returns a definition in its normalized form
+// serialized into the devicetype-library format.
+func normalizeDeviceTypeLibrary(definition string) (string, error) {
+	deviceType, _, err := parseDeviceTypeLibrary(definition)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalDeviceTypeLibrary(deviceType.normalize())
+}
+
+func marshalDeviceTypeLibrary(definition map[string]any) (string, error) {
+	b, err := yaml.Marshal(definition)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// The positions of the ports are optional and default to 1
+func libraryPosition(position *int) int {
+	if position == nil {
+		return 1
+	}
+
+	return *position
+}
+
+func librarySlug(name string) string {
+	return strings.Trim(librarySlugRegexp.ReplaceAllString(
+		strings.ToLower(name), "-"), "-")
+}
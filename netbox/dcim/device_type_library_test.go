@@ -0,0 +1,258 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testDeviceTypeLibraryDefinition = `
+manufacturer: Acme
+model: Switch 48
+slug: acme-switch-48
+u_height: 2
+rear-ports:
+  - name: rear0
+    type: lc
+    positions: 2
+front-ports:
+  - name: front1
+    type: lc
+    rear_port: rear0
+    rear_port_position: 2
+  - name: front0
+    type: lc
+    rear_port: rear0
+interfaces:
+  - name: eth0
+    type: 1000base-t
+`
+
+func TestParseDeviceTypeLibrary(t *testing.T) {
+	definition := testDeviceTypeLibraryDefinition + `
+images:
+  front: true
+console-server-ports:
+  - name: con0
+`
+
+	deviceType, ignoredKeys, err := parseDeviceTypeLibrary(definition)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deviceType.Model != "Switch 48" {
+		t.Errorf("model = %q, want %q", deviceType.Model, "Switch 48")
+	}
+
+	wantIgnoredKeys := []string{"console-server-ports", "images"}
+	if !reflect.DeepEqual(ignoredKeys, wantIgnoredKeys) {
+		t.Errorf("ignored keys = %v, want %v", ignoredKeys, wantIgnoredKeys)
+	}
+
+	templates := deviceType.templates()
+	frontPorts := templates["front_port_template"]
+	if len(frontPorts) != 2 {
+		t.Fatalf("%d front ports, want 2", len(frontPorts))
+	}
+
+	// The position of the rear port defaults to 1
+	if p := frontPorts[1].(map[string]any)["rear_port_position"]; p != 1 {
+		t.Errorf("rear_port_position = %v, want 1", p)
+	}
+
+	// Interfaces are enabled by default
+	interfaces := templates["interface_template"]
+	if e := interfaces[0].(map[string]any)["enabled"]; e != true {
+		t.Errorf("enabled = %v, want true", e)
+	}
+}
+
+func TestParseDeviceTypeLibraryInvalidYAML(t *testing.T) {
+	if _, _, err := parseDeviceTypeLibrary("model: [a"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestDeviceTypeLibraryValidate(t *testing.T) {
+	cases := map[string]struct {
+		definition string
+		errors     []string
+	}{
+		"valid": {
+			definition: testDeviceTypeLibraryDefinition,
+		},
+		"missing required keys": {
+			definition: "part_number: x",
+			errors: []string{
+				"manufacturer is required",
+				"model is required",
+				"slug is required",
+			},
+		},
+		"invalid values": {
+			definition: `
+manufacturer: Acme
+model: m
+slug: m
+airflow: up
+subdevice_role: sibling
+weight_unit: t
+`,
+			errors: []string{"up", "sibling", "t"},
+		},
+		"duplicate names": {
+			definition: `
+manufacturer: Acme
+model: m
+slug: m
+interfaces:
+  - name: eth0
+    type: 1000base-t
+  - name: eth0
+    type: 1000base-t
+  - type: 1000base-t
+`,
+			errors: []string{
+				"interfaces: eth0 is defined twice",
+				"interfaces: name is required",
+			},
+		},
+		"invalid component types": {
+			definition: `
+manufacturer: Acme
+model: m
+slug: m
+console-ports:
+  - name: con0
+    type: usb-z
+power-ports:
+  - name: psu0
+    type: plug
+`,
+			errors: []string{"console-ports: con0", "power-ports: psu0"},
+		},
+		"positions out of range": {
+			definition: `
+manufacturer: Acme
+model: m
+slug: m
+rear-ports:
+  - name: rear0
+    type: lc
+    positions: 0
+  - name: rear1
+    type: lc
+    positions: 2
+front-ports:
+  - name: front0
+    type: lc
+    rear_port: rear1
+    rear_port_position: 3
+  - name: front1
+    type: lc
+    rear_port: rear1
+    rear_port_position: 0
+  - name: front2
+    type: lc
+    rear_port: rear2
+`,
+			errors: []string{
+				"rear-ports: rear0: positions must be between 1 and 1024",
+				"front-ports: front0: rear_port_position must be between " +
+					"1 and 2",
+				"front-ports: front1: rear_port_position must be between " +
+					"1 and 2",
+				"front-ports: front2: rear port \"rear2\" is not defined",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := parseDeviceTypeLibrary(c.definition)
+			if len(c.errors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			for _, e := range c.errors {
+				if !strings.Contains(err.Error(), e) {
+					t.Errorf("error %q does not contain %q", err, e)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeDeviceTypeLibrary(t *testing.T) {
+	reordered := `
+slug: acme-switch-48
+model: Switch 48
+manufacturer: Acme
+u_height: 2.0
+is_full_depth: true
+unsupported: ignored
+interfaces:
+  - name: eth0
+    type: 1000base-t
+    enabled: true
+front-ports:
+  - name: front0
+    type: lc
+    rear_port: rear0
+    rear_port_position: 1
+  - name: front1
+    type: lc
+    rear_port: rear0
+    rear_port_position: 2
+rear-ports:
+  - name: rear0
+    type: lc
+    positions: 2
+`
+
+	expected, err := normalizeDeviceTypeLibrary(
+		testDeviceTypeLibraryDefinition)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual, err := normalizeDeviceTypeLibrary(reordered)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if actual != expected {
+		t.Errorf("normalized definitions differ:\n%s\n%s", actual, expected)
+	}
+
+	// The normalized form is itself a valid definition
+	again, err := normalizeDeviceTypeLibrary(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if again != expected {
+		t.Errorf("normalized definition is not stable:\n%s\n%s", again,
+			expected)
+	}
+
+	if !diffSuppressDeviceTypeLibrary("", expected, reordered, nil) {
+		t.Error("equivalent definitions are not suppressed")
+	}
+
+	changed := strings.Replace(reordered, "u_height: 2.0", "u_height: 1", 1)
+	if diffSuppressDeviceTypeLibrary("", expected, changed, nil) {
+		t.Error("different definitions are suppressed")
+	}
+}
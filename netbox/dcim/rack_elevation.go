@@ -0,0 +1,247 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+var rackFaces = []string{"front", "rear"}
+
+// rackPlacementMutex serializes the allocations of rack positions so that two
+// devices created at the same time do not get the same position.
+var rackPlacementMutex sync.Mutex
+
+// rackUnit is a unit of the elevation of a rack
+type rackUnit struct {
+	DeviceID   int
+	DeviceName string
+	Face       string
+	Name       string
+	Occupied   bool
+	Unit       float64
+}
+
+// rackFreeRange is a range of contiguous free units of a rack
+type rackFreeRange struct {
+	First int
+	Last  int
+}
+
+// getRackElevation returns the units of a face of a rack sorted from the
+// lowest to the highest one, the device with the exclude ID (if not 0) is
+// not taken into account.
+func getRackElevation(ctx context.Context, client *netbox.APIClient,
+	rackID int32, face string, exclude int32) ([]rackUnit, diag.Diagnostics) {
+
+	faceParameter, err := netbox.
+		NewDcimRacksElevationRetrieveFaceParameterFromValue(face)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	// The empty units have no device and the unit numbers are decimals
+	// rendered as strings which the generated client fails to decode, the raw
+	// response is decoded instead.
+	request := client.DcimAPI.DcimRacksElevationRetrieve(ctx,
+		rackID).Face(*faceParameter).Limit(util.Const1000)
+	if exclude != 0 {
+		request = request.Exclude(exclude)
+	}
+
+	_, response, err := request.Execute()
+	if response == nil || response.StatusCode != util.Const200 {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	units, err := decodeRackUnits(body)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	return units, nil
+}
+
+func decodeRackUnits(body []byte) ([]rackUnit, error) {
+	var elevation struct {
+		Results []struct {
+			Device *struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"device"`
+			Face struct {
+				Value string `json:"value"`
+			} `json:"face"`
+			ID       json.Number `json:"id"`
+			Name     string      `json:"name"`
+			Occupied bool        `json:"occupied"`
+		} `json:"results"`
+	}
+
+	if err := json.Unmarshal(body, &elevation); err != nil {
+		return nil, err
+	}
+
+	units := []rackUnit{}
+	for _, result := range elevation.Results {
+		unit, err := strconv.ParseFloat(result.ID.String(), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		u := rackUnit{
+			Face:     result.Face.Value,
+			Name:     result.Name,
+			Occupied: result.Occupied || result.Device != nil,
+			Unit:     unit,
+		}
+
+		if result.Device != nil {
+			u.DeviceID = result.Device.ID
+			u.DeviceName = result.Device.Name
+		}
+
+		units = append(units, u)
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].Unit < units[j].Unit
+	})
+
+	return units, nil
+}
+
+// getRackFreeRanges returns the ranges of contiguous free whole units of the
+// given elevations which can hold a device of the given height. A unit is
+// free only if it is free in every elevation.
+func getRackFreeRanges(height int,
+	elevations ...[]rackUnit) []rackFreeRange {
+
+	free := map[int]bool{}
+	for i, units := range elevations {
+		freeInElevation := map[int]bool{}
+		for _, u := range units {
+			if u.Unit != math.Trunc(u.Unit) || u.Occupied {
+				continue
+			}
+			if i == 0 || free[int(u.Unit)] {
+				freeInElevation[int(u.Unit)] = true
+			}
+		}
+		free = freeInElevation
+	}
+
+	positions := []int{}
+	for p := range free {
+		positions = append(positions, p)
+	}
+	sort.Ints(positions)
+
+	ranges := []rackFreeRange{}
+	for _, p := range positions {
+		last := len(ranges) - 1
+		if last >= 0 && ranges[last].Last == p-1 {
+			ranges[last].Last = p
+		} else {
+			ranges = append(ranges, rackFreeRange{First: p, Last: p})
+		}
+	}
+
+	fitting := []rackFreeRange{}
+	for _, r := range ranges {
+		if r.Last-r.First+1 >= height {
+			fitting = append(fitting, r)
+		}
+	}
+
+	return fitting
+}
+
+// getRackAvailablePositions returns the positions, sorted from the lowest to
+// the highest, where a device of the given height can be installed.
+func getRackAvailablePositions(height int,
+	elevations ...[]rackUnit) []int {
+
+	positions := []int{}
+	for _, r := range getRackFreeRanges(height, elevations...) {
+		for p := r.First; p+height-1 <= r.Last; p++ {
+			positions = append(positions, p)
+		}
+	}
+
+	return positions
+}
+
+// selectRackPosition returns the lowest or the highest position, according
+// to the preference, where a device of the given height can be installed.
+func selectRackPosition(height int, preference string,
+	elevations ...[]rackUnit) (int, bool) {
+
+	positions := getRackAvailablePositions(height, elevations...)
+	if len(positions) == 0 {
+		return 0, false
+	}
+
+	if preference == "highest" {
+		return positions[len(positions)-1], true
+	}
+
+	return positions[0], true
+}
+
+// getRackPlacementPosition returns the position chosen for a device of the
+// given height and rack placement, both faces of the rack must be free for a
+// full depth device. The caller must hold rackPlacementMutex until the device
+// is saved.
+func getRackPlacementPosition(ctx context.Context, client *netbox.APIClient,
+	placement map[string]any, deviceID int32, height int,
+	fullDepth bool) (float64, diag.Diagnostics) {
+
+	rackID, err := safecast.ToInt32(placement["rack_id"].(int))
+	if err != nil {
+		return 0, util.GenerateErrorMessage(nil, err)
+	}
+
+	faces := []string{placement["face"].(string)}
+	if fullDepth {
+		faces = rackFaces
+	}
+
+	elevations := [][]rackUnit{}
+	for _, face := range faces {
+		units, errDiag := getRackElevation(ctx, client, rackID, face,
+			deviceID)
+		if errDiag != nil {
+			return 0, errDiag
+		}
+		elevations = append(elevations, units)
+	}
+
+	position, ok := selectRackPosition(height,
+		placement["preference"].(string), elevations...)
+	if !ok {
+		return 0, util.GenerateErrorMessage(nil,
+			fmt.Errorf("No free position of %d units found in rack %d",
+				height, rackID))
+	}
+
+	return float64(position), nil
+}
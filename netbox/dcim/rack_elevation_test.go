@@ -0,0 +1,136 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testRackUnits returns the whole and half units of a rack of the given
+// height, the given units being occupied.
+func testRackUnits(height int, occupied ...float64) []rackUnit {
+	isOccupied := map[float64]bool{}
+	for _, u := range occupied {
+		isOccupied[u] = true
+	}
+
+	units := []rackUnit{}
+	for u := 1.0; u <= float64(height); u += 0.5 {
+		units = append(units, rackUnit{Unit: u, Occupied: isOccupied[u]})
+	}
+
+	return units
+}
+
+func TestDecodeRackUnits(t *testing.T) {
+	body := []byte(`{"count": 3, "results": [
+		{"id": "2", "name": "U2", "face": {"value": "front"},
+		 "device": {"id": 7, "name": "server1"}, "occupied": true},
+		{"id": 1.5, "name": "U1.5", "face": {"value": "front"},
+		 "device": null, "occupied": false},
+		{"id": "1", "name": "U1", "face": {"value": "front"},
+		 "device": null, "occupied": false}
+	]}`)
+
+	units, err := decodeRackUnits(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []rackUnit{
+		{Face: "front", Name: "U1", Unit: 1},
+		{Face: "front", Name: "U1.5", Unit: 1.5},
+		{DeviceID: 7, DeviceName: "server1", Face: "front", Name: "U2",
+			Occupied: true, Unit: 2},
+	}
+
+	if !reflect.DeepEqual(units, want) {
+		t.Errorf("got %v, want %v", units, want)
+	}
+}
+
+func TestGetRackFreeRanges(t *testing.T) {
+	cases := map[string]struct {
+		height     int
+		elevations [][]rackUnit
+		want       []rackFreeRange
+	}{
+		"empty rack": {
+			height:     1,
+			elevations: [][]rackUnit{testRackUnits(10)},
+			want:       []rackFreeRange{{First: 1, Last: 10}},
+		},
+		"occupied units": {
+			height:     1,
+			elevations: [][]rackUnit{testRackUnits(10, 3, 4, 8)},
+			want: []rackFreeRange{
+				{First: 1, Last: 2},
+				{First: 5, Last: 7},
+				{First: 9, Last: 10},
+			},
+		},
+		"ranges too small": {
+			height:     3,
+			elevations: [][]rackUnit{testRackUnits(10, 3, 4, 8)},
+			want:       []rackFreeRange{{First: 5, Last: 7}},
+		},
+		"half units are ignored": {
+			height:     1,
+			elevations: [][]rackUnit{testRackUnits(4, 2.5)},
+			want:       []rackFreeRange{{First: 1, Last: 4}},
+		},
+		"both faces must be free": {
+			height: 2,
+			elevations: [][]rackUnit{
+				testRackUnits(10, 2),
+				testRackUnits(10, 6, 7),
+			},
+			want: []rackFreeRange{
+				{First: 3, Last: 5},
+				{First: 8, Last: 10},
+			},
+		},
+		"full rack": {
+			height:     1,
+			elevations: [][]rackUnit{testRackUnits(2, 1, 2)},
+			want:       []rackFreeRange{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getRackFreeRanges(c.height, c.elevations...)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSelectRackPosition(t *testing.T) {
+	units := testRackUnits(10, 3, 4, 8)
+
+	cases := map[string]struct {
+		height     int
+		preference string
+		want       int
+		ok         bool
+	}{
+		"lowest":             {1, "lowest", 1, true},
+		"highest":            {1, "highest", 10, true},
+		"lowest of 3 units":  {3, "lowest", 5, true},
+		"highest of 2 units": {2, "highest", 9, true},
+		"no free position":   {4, "lowest", 0, false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := selectRackPosition(c.height, c.preference, units)
+			if got != c.want || ok != c.ok {
+				t.Errorf("got %d, %t, want %d, %t", got, ok, c.want, c.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,560 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+// Object types which can be connected by a cable
+var cableTerminationObjectTypes = []string{
+	"circuits.circuittermination",
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.powerfeed",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+func cableTerminationSchema(side string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Description: "The terminations of the " + side + " side of this " +
+			"cable, all of them must have the same object type.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_id": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The ID of the object of this termination.",
+				},
+				"object_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						cableTerminationObjectTypes, false),
+					Description: "The type of the object of this " +
+						"termination (dcim.interface, dcim.frontport, ...).",
+				},
+			},
+		},
+	}
+}
+
+func ResourceNetboxDcimCable() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a cable within Netbox.",
+		CreateContext: resourceNetboxDcimCableCreate,
+		ReadContext:   resourceNetboxDcimCableRead,
+		UpdateContext: resourceNetboxDcimCableUpdate,
+		DeleteContext: resourceNetboxDcimCableDelete,
+		Exists:        resourceNetboxDcimCableExists,
+		CustomizeDiff: resourceNetboxDcimCableCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"a_termination": cableTerminationSchema("A"),
+			"b_termination": cableTerminationSchema("B"),
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(colorRegexp,
+					"Must be like 00ff00"),
+				Description: "The color of this cable.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments for this cable.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this cable.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this cable was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this cable.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const100),
+				Description:  "The label of this cable.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this cable was last updated.",
+			},
+			"length": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The length of this cable.",
+			},
+			"length_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"length"},
+				ValidateFunc: validation.StringInSlice([]string{"km", "m",
+					"cm", "mi", "ft", "in"}, false),
+				Description: "The unit among km, m, cm, mi, ft or in of the " +
+					"length of this cable.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "connected",
+				ValidateFunc: validation.StringInSlice([]string{"connected",
+					"planned", "decommissioning"}, false),
+				Description: "The status among connected, planned or " +
+					"decommissioning of this cable (connected by default).",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this cable.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(netbox.AllowedCableTypeEnumValues),
+					false),
+				Description: "The type of this cable (cat6, smf, power, ...).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this cable.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimCableCustomizeDiff(_ context.Context,
+	d *schema.ResourceDiff, _ any) error {
+
+	for _, side := range []string{"a_termination", "b_termination"} {
+		objectType := ""
+		for _, t := range d.Get(side).(*schema.Set).List() {
+			termination := t.(map[string]any)
+			ot := termination["object_type"].(string)
+
+			// The object type is unknown until apply
+			if ot == "" {
+				continue
+			}
+
+			if objectType != "" && objectType != ot {
+				return fmt.Errorf("all the terminations of %s must have the "+
+					"same object type (%s and %s found)", side, objectType, ot)
+			}
+			objectType = ot
+		}
+	}
+
+	return nil
+}
+
+func expandCableTerminations(terminations []any) (
+	[]netbox.GenericObjectRequest, error) {
+
+	var requests []netbox.GenericObjectRequest
+	for _, t := range terminations {
+		termination := t.(map[string]any)
+		objectID, err := safecast.ToInt32(termination["object_id"].(int))
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, *netbox.NewGenericObjectRequest(
+			termination["object_type"].(string), objectID))
+	}
+
+	return requests, nil
+}
+
+func flattenCableTerminations(terminations []netbox.GenericObject) []any {
+	var s []any
+	for _, t := range terminations {
+		s = append(s, map[string]any{
+			"object_id":   int(t.GetObjectId()),
+			"object_type": t.GetObjectType(),
+		})
+	}
+
+	return s
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimCableCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	aTerminations, err := expandCableTerminations(
+		d.Get("a_termination").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	bTerminations, err := expandCableTerminations(
+		d.Get("b_termination").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableCableRequestWithDefaults()
+	newResource.SetATerminations(aTerminations)
+	newResource.SetBTerminations(bTerminations)
+	newResource.SetColor(d.Get("color").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetLabel(d.Get("label").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if length, ok := d.GetOk("length"); ok {
+		newResource.SetLength(length.(float64))
+	}
+
+	if lengthUnit := d.Get("length_unit").(string); lengthUnit != "" {
+		u, err := netbox.NewCableLengthUnitValueFromValue(lengthUnit)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetLengthUnit(*u)
+	}
+
+	status, err := netbox.NewCableStatusValueFromValue(
+		d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetStatus(*status)
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if err != nil {
+			return err
+		}
+		newResource.SetTenant(*b)
+	}
+
+	cableType, err := netbox.NewCableTypeFromValue(d.Get("type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetType(*cableType)
+
+	_, response, err := client.DcimAPI.DcimCablesCreate(
+		ctx).WritableCableRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimCableRead(ctx, d, m)
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimCableRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimCablesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("a_termination", flattenCableTerminations(
+		resource.GetATerminations())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("b_termination", flattenCableTerminations(
+		resource.GetBTerminations())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("color", resource.GetColor()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("label", resource.GetLabel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("length", resource.GetLength()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("length_unit",
+		resource.GetLengthUnit().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("type", resource.GetType()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimCableUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableCableRequestWithDefaults()
+
+	// Required fields
+	aTerminations, err := expandCableTerminations(
+		d.Get("a_termination").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetATerminations(aTerminations)
+
+	bTerminations, err := expandCableTerminations(
+		d.Get("b_termination").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetBTerminations(bTerminations)
+
+	if d.HasChange("color") {
+		resource.SetColor(d.Get("color").(string))
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("label") {
+		resource.SetLabel(d.Get("label").(string))
+	}
+
+	if d.HasChange("length") {
+		if length, ok := d.GetOk("length"); ok {
+			resource.SetLength(length.(float64))
+		} else {
+			resource.SetLengthNil()
+		}
+	}
+
+	if d.HasChange("length_unit") {
+		u, err := netbox.NewCableLengthUnitValueFromValue(
+			d.Get("length_unit").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetLengthUnit(*u)
+	}
+
+	if d.HasChange("status") {
+		status, err := netbox.NewCableStatusValueFromValue(
+			d.Get("status").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetStatus(*status)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+			if err != nil {
+				return err
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if d.HasChange("type") {
+		cableType, err := netbox.NewCableTypeFromValue(d.Get("type").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetType(*cableType)
+	}
+
+	if _, response, err := client.DcimAPI.DcimCablesUpdate(ctx,
+		int32(resourceID)).WritableCableRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimCableRead(ctx, d, m)
+}
+
+func resourceNetboxDcimCableDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimCableExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimCablesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimCableExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimCablesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
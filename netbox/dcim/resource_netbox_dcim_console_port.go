@@ -0,0 +1,408 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimConsolePort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a console port of a device within Netbox.",
+		CreateContext: resourceNetboxDcimConsolePortCreate,
+		ReadContext:   resourceNetboxDcimConsolePortRead,
+		UpdateContext: resourceNetboxDcimConsolePortUpdate,
+		DeleteContext: resourceNetboxDcimConsolePortDelete,
+		Exists:        resourceNetboxDcimConsolePortExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this console port.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this console port was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this console port.",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the device of this console port.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const64),
+				Description:  "The physical label of this console port.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this console port was last updated.",
+			},
+			"mark_connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Treat this console port as if a cable is " +
+					"connected.",
+			},
+			"module_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the module of this console port.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this console port.",
+			},
+			"speed": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ValidateFunc: validation.IntInSlice(util.EnumToListofInts(
+					netbox.AllowedPatchedWritableConsolePortRequestSpeedEnumValues)),
+				Description: "The speed (bps) of this console port " +
+					"(9600, 115200, ...).",
+			},
+			"tag": &tag.TagSchema,
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedPatchedWritableConsolePortRequestTypeEnumValues),
+					false),
+				Description: "The type of this console port " +
+					"(de-9, rj-45, usb-c, ...).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this console port.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimConsolePortCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewWritableConsolePortRequest(*b,
+		d.Get("name").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetLabel(d.Get("label").(string))
+	newResource.SetMarkConnected(d.Get("mark_connected").(bool))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if moduleID := d.Get("module_id").(int); moduleID != 0 {
+		b, err := brief.GetBriefModuleRequestFromID(ctx, client, moduleID)
+		if err != nil {
+			return err
+		}
+		newResource.SetModule(*b)
+	}
+
+	if speed := d.Get("speed").(int); speed != 0 {
+		speed32, err := safecast.ToInt32(speed)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		s, err := netbox.NewPatchedWritableConsolePortRequestSpeedFromValue(
+			speed32)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetSpeed(*s)
+	}
+
+	if consolePortType := d.Get("type").(string); consolePortType != "" {
+		t, err := netbox.NewPatchedWritableConsolePortRequestTypeFromValue(
+			consolePortType)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetType(*t)
+	}
+
+	_, response, err := client.DcimAPI.DcimConsolePortsCreate(
+		ctx).WritableConsolePortRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimConsolePortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimConsolePortRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimConsolePortsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_id", resource.GetDevice().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("label", resource.GetLabel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mark_connected", resource.GetMarkConnected()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("module_id", resource.GetModule().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	speed := resource.GetSpeed()
+	if err = d.Set("speed", int(speed.GetValue())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("type", resource.GetType().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableConsolePortRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetDevice(*b)
+	resource.SetName(d.Get("name").(string))
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("label") {
+		resource.SetLabel(d.Get("label").(string))
+	}
+
+	if d.HasChange("mark_connected") {
+		resource.SetMarkConnected(d.Get("mark_connected").(bool))
+	}
+
+	if d.HasChange("module_id") {
+		if moduleID := d.Get("module_id").(int); moduleID != 0 {
+			b, err := brief.GetBriefModuleRequestFromID(ctx, client, moduleID)
+			if err != nil {
+				return err
+			}
+			resource.SetModule(*b)
+		} else {
+			resource.SetModuleNil()
+		}
+	}
+
+	if d.HasChange("speed") {
+		if speed := d.Get("speed").(int); speed != 0 {
+			speed32, err := safecast.ToInt32(speed)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			s, err := netbox.
+				NewPatchedWritableConsolePortRequestSpeedFromValue(speed32)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetSpeed(*s)
+		} else {
+			resource.SetSpeedNil()
+		}
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("type") {
+		t, err := netbox.NewPatchedWritableConsolePortRequestTypeFromValue(
+			d.Get("type").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetType(*t)
+	}
+
+	if _, response, err := client.DcimAPI.DcimConsolePortsUpdate(ctx,
+		int32(resourceID)).WritableConsolePortRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimConsolePortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimConsolePortDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimConsolePortExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimConsolePortsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimConsolePortsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
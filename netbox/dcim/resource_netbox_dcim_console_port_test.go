@@ -0,0 +1,183 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimConsolePort = "netbox_dcim_console_port.test"
+
+func TestAccNetboxDcimConsolePortMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsolePort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimConsolePortFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsolePort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimConsolePortMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimConsolePortConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimconsoleport-{{ .namesuffix }}"
+		slug = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimconsoleport-{{ .namesuffix }}"
+		slug            = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimconsoleport-{{ .namesuffix }}"
+		slug = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimconsoleport-{{ .namesuffix }}"
+		slug = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimconsoleport-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimconsoleport-{{ .namesuffix }}"
+		slug = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module_bay" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimconsoleport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module" "test" {
+		device_id      = netbox_dcim_device.test.id
+		module_bay_id  = netbox_dcim_module_bay.test.id
+		module_type_id = netbox_dcim_module_type.test.id
+	}
+	{{ end }}
+
+	resource "netbox_dcim_console_port" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimconsoleport-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		description = "Test console port"
+		label = "CON1"
+		mark_connected = true
+		module_id = netbox_dcim_module.test.id
+		speed = 115200
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		type = "rj-45"
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
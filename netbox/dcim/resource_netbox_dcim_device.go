@@ -0,0 +1,881 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimDevice() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a device within Netbox.",
+		CreateContext: resourceNetboxDcimDeviceCreate,
+		ReadContext:   resourceNetboxDcimDeviceRead,
+		UpdateContext: resourceNetboxDcimDeviceUpdate,
+		DeleteContext: resourceNetboxDcimDeviceDelete,
+		Exists:        resourceNetboxDcimDeviceExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"airflow": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"front-to-rear", "rear-to-front", "left-to-right",
+					"right-to-left", "side-to-rear", "passive", "mixed"},
+					false),
+				Description: "The airflow among front-to-rear, " +
+					"rear-to-front, left-to-right, right-to-left, " +
+					"side-to-rear, passive or mixed of this device.",
+			},
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "A unique tag used to identify this device.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the cluster hosted by this device.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this device.",
+			},
+			"config_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The config template used for this device.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this device.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this device.",
+			},
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the device type of this device.",
+			},
+			"face": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"front",
+					"rear"}, false),
+				RequiredWith:     []string{"position"},
+				DiffSuppressFunc: suppressWithRackPlacement,
+				Description: "The rack face among front or rear where this " +
+					"device is mounted.",
+			},
+			"interface_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of interfaces of this device.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device was last updated.",
+			},
+			"local_context_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Local context data for this device.",
+			},
+			"location_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the location of this device.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this device.",
+			},
+			"platform_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the platform of this device.",
+			},
+			"position": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				RequiredWith:     []string{"rack_id", "face"},
+				DiffSuppressFunc: suppressWithRackPlacement,
+				Description: "The lowest rack unit occupied by this " +
+					"device.",
+			},
+			"primary_ip4": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary IPv4 of this device.",
+			},
+			"primary_ip6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary IPv6 of this device.",
+			},
+			"rack_id": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DiffSuppressFunc: suppressWithRackPlacement,
+				Description:      "ID of the rack where this device is mounted.",
+			},
+			"rack_placement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"face", "position", "rack_id"},
+				Description: "Let the provider choose the position of this " +
+					"device in a rack. The first free position is chosen " +
+					"at creation (or when this block changes) and kept " +
+					"afterwards, the chosen rack, face and position are " +
+					"available in rack_id, face and position.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"face": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "front",
+							ValidateFunc: validation.StringInSlice(rackFaces, false),
+							Description: "The rack face among front or rear " +
+								"(front by default) where this device is mounted.",
+						},
+						"preference": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "lowest",
+							ValidateFunc: validation.StringInSlice([]string{
+								"lowest", "highest"}, false),
+							Description: "Choose the lowest or the highest " +
+								"free position (lowest by default).",
+						},
+						"rack_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+							Description: "ID of the rack where this device " +
+								"is mounted.",
+						},
+						"u_height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description: "The number of units needed by " +
+								"this device. It is taken from the device " +
+								"type by default and must match it when set.",
+						},
+					},
+				},
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the role of this device.",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "The serial number of this device.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the site where this device is located.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice([]string{"offline",
+					"active", "planned", "staged", "failed", "inventory",
+					"decommissioning"}, false),
+				Description: "The status among offline, active, planned, " +
+					"staged, failed, inventory or decommissioning " +
+					"(active by default) of this device.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this device.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this device.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimDeviceCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := netbox.NewWritableDeviceWithConfigContextRequestWithDefaults()
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetSerial(d.Get("serial").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if name := d.Get("name").(string); name != "" {
+		newResource.SetName(name)
+	}
+
+	if assetTag := d.Get("asset_tag").(string); assetTag != "" {
+		newResource.SetAssetTag(assetTag)
+	}
+
+	b, errDiag := brief.GetBriefDeviceTypeRequestFromID(ctx, client,
+		d.Get("device_type_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	newResource.SetDeviceType(*b)
+
+	r, errDiag := brief.GetBriefDeviceRoleRequestFromID(ctx, client,
+		d.Get("role_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	newResource.SetRole(*r)
+
+	s, errDiag := brief.GetBriefSiteRequestFromID(ctx, client,
+		d.Get("site_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	newResource.SetSite(*s)
+
+	status, err := netbox.NewDeviceStatusValueFromValue(
+		d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetStatus(*status)
+
+	if airflow := d.Get("airflow").(string); airflow != "" {
+		a, err := netbox.NewDeviceAirflowValueFromValue(airflow)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetAirflow(*a)
+	}
+
+	if clusterID := d.Get("cluster_id").(int); clusterID != 0 {
+		b, err := brief.GetBriefClusterRequestFromID(ctx, client, clusterID)
+		if err != nil {
+			return err
+		}
+		newResource.SetCluster(*b)
+	}
+
+	if configTemplateID :=
+		d.Get("config_template_id").(int); configTemplateID != 0 {
+		b, err := brief.GetBriefConfigTemplateRequestFromID(ctx, client,
+			configTemplateID)
+		if err != nil {
+			return err
+		}
+		newResource.SetConfigTemplate(*b)
+	}
+
+	if localContextData :=
+		d.Get("local_context_data").(string); localContextData != "" {
+		var localContextDataMap map[string]*any
+		if err := json.Unmarshal([]byte(localContextData),
+			&localContextDataMap); err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetLocalContextData(localContextDataMap)
+	}
+
+	if locationID := d.Get("location_id").(int); locationID != 0 {
+		b, err := brief.GetBriefLocationRequestFromID(ctx, client, locationID)
+		if err != nil {
+			return err
+		}
+		newResource.SetLocation(*b)
+	}
+
+	if platformID := d.Get("platform_id").(int); platformID != 0 {
+		b, err := brief.GetBriefPlatformRequestFromID(ctx, client, platformID)
+		if err != nil {
+			return err
+		}
+		newResource.SetPlatform(*b)
+	}
+
+	if rackID := d.Get("rack_id").(int); rackID != 0 {
+		b, err := brief.GetBriefRackRequestFromID(ctx, client, rackID)
+		if err != nil {
+			return err
+		}
+		newResource.SetRack(*b)
+	}
+
+	if position := d.Get("position").(float64); position != 0 {
+		newResource.SetPosition(position)
+	}
+
+	face, err := netbox.NewRackFace1FromValue(d.Get("face").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetFace(*face)
+
+	if placement := d.Get("rack_placement").([]any); len(placement) > 0 {
+		rackPlacementMutex.Lock()
+		defer rackPlacementMutex.Unlock()
+
+		errDiag := setDeviceRackPlacement(ctx, client, d, newResource,
+			placement[0].(map[string]any), 0)
+		if errDiag != nil {
+			return errDiag
+		}
+	}
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if err != nil {
+			return err
+		}
+		newResource.SetTenant(*b)
+	}
+
+	_, response, err := client.DcimAPI.DcimDevicesCreate(
+		ctx).WritableDeviceWithConfigContextRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimDeviceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimDevicesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("airflow", resource.GetAirflow().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("asset_tag", resource.GetAssetTag()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("cluster_id", resource.GetCluster().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("config_template_id",
+		resource.GetConfigTemplate().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_type_id",
+		resource.GetDeviceType().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("face", resource.GetFace().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("interface_count",
+		resource.GetInterfaceCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	localContextDataJSON, err :=
+		util.GetLocalContextData(resource.GetLocalContextData())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("local_context_data", localContextDataJSON); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("location_id", resource.GetLocation().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("platform_id", resource.GetPlatform().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("position", resource.GetPosition()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("primary_ip4",
+		resource.GetPrimaryIp4().Address); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("primary_ip6",
+		resource.GetPrimaryIp6().Address); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rack_id", resource.GetRack().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("role_id", resource.GetRole().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("serial", resource.GetSerial()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("site_id", resource.GetSite().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimDeviceUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableDeviceWithConfigContextRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefDeviceTypeRequestFromID(ctx, client,
+		d.Get("device_type_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetDeviceType(*b)
+
+	r, errDiag := brief.GetBriefDeviceRoleRequestFromID(ctx, client,
+		d.Get("role_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetRole(*r)
+
+	s, errDiag := brief.GetBriefSiteRequestFromID(ctx, client,
+		d.Get("site_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetSite(*s)
+
+	// The rack, position and face are validated together by Netbox
+	if rackID := d.Get("rack_id").(int); rackID != 0 {
+		b, err := brief.GetBriefRackRequestFromID(ctx, client, rackID)
+		if err != nil {
+			return err
+		}
+		resource.SetRack(*b)
+	} else {
+		resource.SetRackNil()
+	}
+
+	if position := d.Get("position").(float64); position != 0 {
+		resource.SetPosition(position)
+	} else {
+		resource.SetPositionNil()
+	}
+
+	face, err := netbox.NewRackFace1FromValue(d.Get("face").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetFace(*face)
+
+	// A new position is chosen only when the placement changes
+	placement := d.Get("rack_placement").([]any)
+	if d.HasChange("rack_placement") && len(placement) > 0 {
+		rackPlacementMutex.Lock()
+		defer rackPlacementMutex.Unlock()
+
+		errDiag := setDeviceRackPlacement(ctx, client, d, resource,
+			placement[0].(map[string]any), int32(resourceID))
+		if errDiag != nil {
+			return errDiag
+		}
+	}
+
+	if d.HasChange("airflow") {
+		a, err := netbox.NewDeviceAirflowValueFromValue(
+			d.Get("airflow").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAirflow(*a)
+	}
+
+	if d.HasChange("asset_tag") {
+		if assetTag := d.Get("asset_tag").(string); assetTag != "" {
+			resource.SetAssetTag(assetTag)
+		} else {
+			resource.SetAssetTagNil()
+		}
+	}
+
+	if d.HasChange("cluster_id") {
+		if clusterID := d.Get("cluster_id").(int); clusterID != 0 {
+			b, err := brief.GetBriefClusterRequestFromID(ctx, client,
+				clusterID)
+			if err != nil {
+				return err
+			}
+			resource.SetCluster(*b)
+		} else {
+			resource.SetClusterNil()
+		}
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("config_template_id") {
+		if configTemplateID :=
+			d.Get("config_template_id").(int); configTemplateID != 0 {
+			b, err := brief.GetBriefConfigTemplateRequestFromID(ctx, client,
+				configTemplateID)
+			if err != nil {
+				return err
+			}
+			resource.SetConfigTemplate(*b)
+		} else {
+			resource.SetConfigTemplateNil()
+		}
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("local_context_data") {
+		localContextData := d.Get("local_context_data").(string)
+		var localContextDataMap map[string]*any
+		if localContextData == "" {
+			localContextDataMap = nil
+		} else if err := json.Unmarshal([]byte(localContextData),
+			&localContextDataMap); err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetLocalContextData(localContextDataMap)
+	}
+
+	if d.HasChange("location_id") {
+		if locationID := d.Get("location_id").(int); locationID != 0 {
+			b, err := brief.GetBriefLocationRequestFromID(ctx, client,
+				locationID)
+			if err != nil {
+				return err
+			}
+			resource.SetLocation(*b)
+		} else {
+			resource.SetLocationNil()
+		}
+	}
+
+	if d.HasChange("name") {
+		if name := d.Get("name").(string); name != "" {
+			resource.SetName(name)
+		} else {
+			resource.SetNameNil()
+		}
+	}
+
+	if d.HasChange("platform_id") {
+		if platformID := d.Get("platform_id").(int); platformID != 0 {
+			b, err := brief.GetBriefPlatformRequestFromID(ctx, client,
+				platformID)
+			if err != nil {
+				return err
+			}
+			resource.SetPlatform(*b)
+		} else {
+			resource.SetPlatformNil()
+		}
+	}
+
+	if d.HasChange("serial") {
+		resource.SetSerial(d.Get("serial").(string))
+	}
+
+	if d.HasChange("status") {
+		s, err := netbox.NewDeviceStatusValueFromValue(
+			d.Get("status").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetStatus(*s)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+			if err != nil {
+				return err
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if _, response, err := client.DcimAPI.DcimDevicesUpdate(ctx,
+		int32(resourceID)).WritableDeviceWithConfigContextRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimDeviceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimDeviceExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimDevicesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimDevicesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// suppressWithRackPlacement suppresses the removal of the rack, face and
+// position chosen for a rack placement.
+func suppressWithRackPlacement(_, _, new string,
+	d *schema.ResourceData) bool {
+
+	placement := d.Get("rack_placement").([]any)
+	return len(placement) > 0 && (new == "" || new == "0")
+}
+
+// setDeviceRackPlacement sets the rack, the face and the first free position
+// of a rack placement on a device, the device being placed (if not 0) is not
+// taken into account.
+func setDeviceRackPlacement(ctx context.Context, client *netbox.APIClient,
+	d *schema.ResourceData,
+	resource *netbox.WritableDeviceWithConfigContextRequest,
+	placement map[string]any, deviceID int32) diag.Diagnostics {
+
+	deviceTypeID, err := safecast.ToInt32(d.Get("device_type_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	deviceType, response, err := client.DcimAPI.DcimDeviceTypesRetrieve(ctx,
+		deviceTypeID).Execute()
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	// The device type may be half a unit high, it still needs a whole unit
+	height := int(math.Ceil(deviceType.GetUHeight()))
+	if height == 0 {
+		return util.GenerateErrorMessage(nil,
+			fmt.Errorf("Device type %d has no height and cannot be placed "+
+				"in a rack", deviceTypeID))
+	}
+
+	if h := placement["u_height"].(int); h != 0 && h != height {
+		return util.GenerateErrorMessage(nil,
+			fmt.Errorf("u_height %d of the rack placement does not match "+
+				"the %d units of device type %d", h, height, deviceTypeID))
+	}
+
+	position, errDiag := getRackPlacementPosition(ctx, client, placement,
+		deviceID, height, deviceType.GetIsFullDepth())
+	if errDiag != nil {
+		return errDiag
+	}
+
+	b, errDiag := brief.GetBriefRackRequestFromID(ctx, client,
+		placement["rack_id"].(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetRack(*b)
+
+	face, err := netbox.NewRackFace1FromValue(placement["face"].(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetFace(*face)
+	resource.SetPosition(position)
+
+	return nil
+}
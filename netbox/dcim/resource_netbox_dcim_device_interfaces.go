@@ -0,0 +1,676 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimDeviceInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the interfaces of a device within Netbox " +
+			"in a single resource. The interfaces already existing on the " +
+			"device (e.g. instantiated from the device type) are adopted " +
+			"when they are declared. The interfaces instantiated from the " +
+			"device type or from a module are kept when they are no longer " +
+			"declared or when this resource is destroyed, only the other " +
+			"ones are deleted.",
+		CreateContext: resourceNetboxDcimDeviceInterfacesCreate,
+		ReadContext:   resourceNetboxDcimDeviceInterfacesRead,
+		UpdateContext: resourceNetboxDcimDeviceInterfacesUpdate,
+		DeleteContext: resourceNetboxDcimDeviceInterfacesDelete,
+		Exists:        resourceNetboxDcimDeviceInterfacesExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delete_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the interfaces of the device which are " +
+					"not declared are deleted, otherwise they are left " +
+					"untouched (false by default).",
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the device where these interfaces " +
+					"are attached to.",
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The interfaces of the device.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringLenBetween(0,
+								util.Const200),
+							Description: "The description of this interface.",
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+							Description: "Is this interface enabled " +
+								"(true by default).",
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of this interface.",
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringLenBetween(0,
+								util.Const64),
+							Description: "The physical label of this interface.",
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(
+									"^([A-Z0-9]{2}:){5}[A-Z0-9]{2}$"),
+								"Must be like AA:AA:AA:AA:AA"),
+							Description: "Mac address of this interface.",
+						},
+						"mark_connected": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							Description: "Treat this interface as if a cable " +
+								"is connected.",
+						},
+						"mgmt_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							Description: "Is this interface only used for " +
+								"out-of-band management.",
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{"access", "tagged", "tagged-all"},
+								false),
+							Description: "The mode among access, tagged, " +
+								"tagged-all of this interface.",
+						},
+						"mtu": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.IntBetween(1,
+								util.Const65536),
+							Description: "The MTU between 1 and 65536 of " +
+								"this interface.",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringLenBetween(1,
+								util.Const64),
+							Description: "The name of this interface.",
+						},
+						"speed": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The speed (Kbps) of this interface.",
+						},
+						"tagged_vlans": {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+							Optional: true,
+							Description: "List of vlan id tagged for this " +
+								"interface.",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								util.EnumToListofStrings(
+									netbox.AllowedInterfaceTypeValueEnumValues),
+								false),
+							Description: "The type of this interface " +
+								"(virtual, 1000base-t, 10gbase-x-sfpp, ...).",
+						},
+						"untagged_vlan": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Vlan ID untagged for this interface.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimDeviceInterfacesCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	if errDiag := reconcileDeviceInterfaces(ctx, client, d); errDiag != nil {
+		return errDiag
+	}
+
+	d.SetId(strconv.Itoa(d.Get("device_id").(int)))
+	return resourceNetboxDcimDeviceInterfacesRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceInterfacesRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	_, response, err := client.DcimAPI.DcimDevicesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	current, errDiag := listDeviceInterfaces(ctx, client, int32(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	// The declared interfaces keep their order, the other interfaces are
+	// only read when they have to be deleted or when nothing is declared yet
+	// (e.g. after an import).
+	declared := d.Get("interface").([]any)
+	read := map[string]bool{}
+	interfaces := []any{}
+	for _, i := range declared {
+		name := i.(map[string]any)["name"].(string)
+		for _, c := range current {
+			if c.GetName() == name {
+				interfaces = append(interfaces, flattenDeviceInterface(c))
+				read[name] = true
+			}
+		}
+	}
+
+	if d.Get("delete_unmanaged").(bool) || len(declared) == 0 {
+		for _, c := range current {
+			if !read[c.GetName()] {
+				interfaces = append(interfaces, flattenDeviceInterface(c))
+			}
+		}
+	}
+
+	if err = d.Set("device_id", resourceID); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("interface", interfaces); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceInterfacesUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	if errDiag := reconcileDeviceInterfaces(ctx, client, d); errDiag != nil {
+		return errDiag
+	}
+
+	return resourceNetboxDcimDeviceInterfacesRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceInterfacesDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimDeviceInterfacesExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	current, errDiag := listDeviceInterfaces(ctx, client, int32(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	instantiated, errDiag := listDeviceInstantiatedInterfaces(ctx, client,
+		int32(resourceID), current)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	managed := map[string]bool{}
+	for _, i := range d.Get("interface").([]any) {
+		managed[i.(map[string]any)["name"].(string)] = true
+	}
+
+	var destroyed []netbox.InterfaceRequest
+	for _, c := range current {
+		if managed[c.GetName()] && !instantiated[c.GetName()] {
+			destroyed = append(destroyed, newDeviceInterfaceIDRequest(c.GetId()))
+		}
+	}
+
+	return destroyDeviceInterfaces(ctx, client, destroyed)
+}
+
+func resourceNetboxDcimDeviceInterfacesExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimDevicesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// reconcileDeviceInterfaces applies the declared interfaces to the device.
+// The interfaces removed from the configuration are deleted unless they are
+// instantiated from the device type or a module, all the undeclared ones are
+// deleted when delete_unmanaged is set. The interfaces adopted from
+// the device are entirely replaced whereas the managed ones are patched.
+//
+//nolint:gocyclo
+func reconcileDeviceInterfaces(ctx context.Context, client *netbox.APIClient,
+	d *schema.ResourceData) diag.Diagnostics {
+
+	deviceID := d.Get("device_id").(int)
+	device, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		deviceID)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	deviceID32, err := safecast.ToInt32(deviceID)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	current, errDiag := listDeviceInterfaces(ctx, client, deviceID32)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	currentByName := map[string]netbox.Interface{}
+	for _, c := range current {
+		currentByName[c.GetName()] = c
+	}
+
+	instantiated, errDiag := listDeviceInstantiatedInterfaces(ctx, client,
+		deviceID32, current)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	stateInterfaces, resourceInterfaces := d.GetChange("interface")
+	managed := map[string]map[string]any{}
+	for _, i := range stateInterfaces.([]any) {
+		iface := i.(map[string]any)
+		managed[iface["name"].(string)] = iface
+	}
+
+	declared := map[string]bool{}
+	for _, i := range resourceInterfaces.([]any) {
+		declared[i.(map[string]any)["name"].(string)] = true
+	}
+
+	var destroyed []netbox.InterfaceRequest
+	for _, c := range current {
+		_, isManaged := managed[c.GetName()]
+		isCreated := isManaged && !instantiated[c.GetName()]
+		if !declared[c.GetName()] &&
+			(isCreated || d.Get("delete_unmanaged").(bool)) {
+			destroyed = append(destroyed, newDeviceInterfaceIDRequest(c.GetId()))
+		}
+	}
+
+	if errDiag := destroyDeviceInterfaces(ctx, client,
+		destroyed); errDiag != nil {
+		return errDiag
+	}
+
+	var adopted, changed []netbox.InterfaceRequest
+	vlans := map[int]*netbox.BriefVLANRequest{}
+	for _, i := range resourceInterfaces.([]any) {
+		iface := i.(map[string]any)
+		name := iface["name"].(string)
+		c, exists := currentByName[name]
+		state, isManaged := managed[name]
+
+		if exists && isManaged && !deviceInterfaceChanged(state, iface) {
+			continue
+		}
+
+		request, errDiag := newDeviceInterfaceRequest(ctx, client, device,
+			iface, vlans)
+		if errDiag != nil {
+			return errDiag
+		}
+
+		switch {
+		case !exists:
+			id, errDiag := createDeviceInterface(ctx, client, device, iface)
+			if errDiag != nil {
+				return errDiag
+			}
+			request.AdditionalProperties = map[string]any{"id": id}
+			changed = append(changed, *request)
+		case isManaged:
+			request.AdditionalProperties = map[string]any{"id": c.GetId()}
+			changed = append(changed, *request)
+		default:
+			request.AdditionalProperties = map[string]any{"id": c.GetId()}
+			adopted = append(adopted, *request)
+		}
+	}
+
+	if len(adopted) > 0 {
+		_, response, err := util.DecodeInterfaceResponse(
+			client.DcimAPI.DcimInterfacesBulkUpdate(ctx).InterfaceRequest(
+				adopted).Execute())
+		if err != nil {
+			return util.GenerateErrorMessage(response, err)
+		}
+	}
+
+	if len(changed) > 0 {
+		_, response, err := util.DecodeInterfaceResponse(
+			client.DcimAPI.DcimInterfacesBulkPartialUpdate(
+				ctx).InterfaceRequest(changed).Execute())
+		if err != nil {
+			return util.GenerateErrorMessage(response, err)
+		}
+	}
+
+	return nil
+}
+
+func listDeviceInterfaces(ctx context.Context, client *netbox.APIClient,
+	deviceID int32) ([]netbox.Interface, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimInterfacesList(ctx).DeviceId(
+		[]int32{deviceID}).Limit(util.Const1000)
+
+	resources, response, err := util.ListAll[netbox.Interface,
+		*netbox.PaginatedInterfaceList](interfacesListRequest{request})
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	return resources, nil
+}
+
+// interfacesListRequest is a list request of interfaces decoding its pages
+// with util.DecodeInterfaceResponse so that it can be used with
+// util.ListAll.
+type interfacesListRequest struct {
+	netbox.ApiDcimInterfacesListRequest
+}
+
+func (r interfacesListRequest) Offset(offset int32) interfacesListRequest {
+	return interfacesListRequest{
+		r.ApiDcimInterfacesListRequest.Offset(offset),
+	}
+}
+
+func (r interfacesListRequest) Execute() (*netbox.PaginatedInterfaceList,
+	*http.Response, error) {
+
+	return util.DecodeInterfaceResponse(
+		r.ApiDcimInterfacesListRequest.Execute())
+}
+
+// listDeviceInstantiatedInterfaces returns the names of the interfaces of
+// the device instantiated from its device type or from a module. They are
+// part of the device and are not deleted when they are no longer managed.
+func listDeviceInstantiatedInterfaces(ctx context.Context,
+	client *netbox.APIClient, deviceID int32,
+	current []netbox.Interface) (map[string]bool, diag.Diagnostics) {
+
+	device, response, err := client.DcimAPI.DcimDevicesRetrieve(ctx,
+		deviceID).Execute()
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	templates, errDiag := listInterfaceTemplates(ctx, client,
+		&templateParent{id: device.DeviceType.GetId()})
+	if errDiag != nil {
+		return nil, errDiag
+	}
+
+	instantiated := map[string]bool{}
+	for _, t := range templates {
+		instantiated[t["name"].(string)] = true
+	}
+
+	for _, c := range current {
+		if c.Module.Get() != nil {
+			instantiated[c.GetName()] = true
+		}
+	}
+
+	return instantiated, nil
+}
+
+// createDeviceInterface creates a bare interface which is completed by the
+// bulk update as go-netbox does not expose the bulk creation of interfaces.
+func createDeviceInterface(ctx context.Context, client *netbox.APIClient,
+	device *netbox.BriefDeviceRequest, iface map[string]any) (int32,
+	diag.Diagnostics) {
+
+	interfaceType, err := netbox.NewInterfaceTypeValueFromValue(
+		iface["type"].(string))
+	if err != nil {
+		return 0, util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableInterfaceRequest(*device,
+		iface["name"].(string), *interfaceType)
+
+	_, response, err := client.DcimAPI.DcimInterfacesCreate(
+		ctx).WritableInterfaceRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return 0, util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return 0, util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceID, nil
+}
+
+func destroyDeviceInterfaces(ctx context.Context, client *netbox.APIClient,
+	interfaces []netbox.InterfaceRequest) diag.Diagnostics {
+
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	if response, err := client.DcimAPI.DcimInterfacesBulkDestroy(
+		ctx).InterfaceRequest(interfaces).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+// newDeviceInterfaceIDRequest returns a request only identifying the
+// interface, as expected by the bulk destroy.
+func newDeviceInterfaceIDRequest(id int32) netbox.InterfaceRequest {
+	request := netbox.NewInterfaceRequestWithDefaults()
+	request.AdditionalProperties = map[string]any{"id": id}
+	return *request
+}
+
+//nolint:gocyclo
+func newDeviceInterfaceRequest(ctx context.Context, client *netbox.APIClient,
+	device *netbox.BriefDeviceRequest, iface map[string]any,
+	vlans map[int]*netbox.BriefVLANRequest) (*netbox.InterfaceRequest,
+	diag.Diagnostics) {
+
+	interfaceType, err := netbox.NewInterfaceTypeValueFromValue(
+		iface["type"].(string))
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	request := netbox.NewInterfaceRequest(*device, iface["name"].(string),
+		*interfaceType)
+	request.SetDescription(iface["description"].(string))
+	request.SetEnabled(iface["enabled"].(bool))
+	request.SetLabel(iface["label"].(string))
+	request.SetMarkConnected(iface["mark_connected"].(bool))
+	request.SetMgmtOnly(iface["mgmt_only"].(bool))
+
+	mode, err := netbox.NewInterfaceModeValueFromValue(
+		iface["mode"].(string))
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+	request.SetMode(*mode)
+
+	if macAddress := iface["mac_address"].(string); macAddress != "" {
+		request.SetMacAddress(macAddress)
+	} else {
+		request.SetMacAddressNil()
+	}
+
+	if mtu := iface["mtu"].(int); mtu != 0 {
+		mtu32, err := safecast.ToInt32(mtu)
+		if err != nil {
+			return nil, util.GenerateErrorMessage(nil, err)
+		}
+		request.SetMtu(mtu32)
+	} else {
+		request.SetMtuNil()
+	}
+
+	if speed := iface["speed"].(int); speed != 0 {
+		speed32, err := safecast.ToInt32(speed)
+		if err != nil {
+			return nil, util.GenerateErrorMessage(nil, err)
+		}
+		request.SetSpeed(speed32)
+	} else {
+		request.SetSpeedNil()
+	}
+
+	taggedVlans, err := util.ExpandToInt32Slice(
+		iface["tagged_vlans"].(*schema.Set).List())
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+	request.SetTaggedVlans(taggedVlans)
+
+	if untaggedVlan := iface["untagged_vlan"].(int); untaggedVlan != 0 {
+		if _, ok := vlans[untaggedVlan]; !ok {
+			b, errDiag := brief.GetBriefVLANRequestFromID(ctx, client,
+				untaggedVlan)
+			if errDiag != nil {
+				return nil, errDiag
+			}
+			vlans[untaggedVlan] = b
+		}
+		request.SetUntaggedVlan(*vlans[untaggedVlan])
+	} else {
+		request.SetUntaggedVlanNil()
+	}
+
+	return request, nil
+}
+
+func deviceInterfaceChanged(current, desired map[string]any) bool {
+	for k, v := range desired {
+		if s, ok := v.(*schema.Set); ok {
+			if !s.Equal(current[k]) {
+				return true
+			}
+		} else if k != "id" && current[k] != v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenDeviceInterface(i netbox.Interface) map[string]any {
+	mode := i.GetMode()
+	interfaceType := i.GetType()
+	untaggedVlan := i.GetUntaggedVlan()
+
+	return map[string]any{
+		"description":    i.GetDescription(),
+		"enabled":        i.GetEnabled(),
+		"id":             int(i.GetId()),
+		"label":          i.GetLabel(),
+		"mac_address":    i.GetMacAddress(),
+		"mark_connected": i.GetMarkConnected(),
+		"mgmt_only":      i.GetMgmtOnly(),
+		"mode":           string(mode.GetValue()),
+		"mtu":            int(i.GetMtu()),
+		"name":           i.GetName(),
+		"speed":          int(i.GetSpeed()),
+		"tagged_vlans": util.ConvertAPIVlansToVlans(
+			i.GetTaggedVlans()),
+		"type":          string(interfaceType.GetValue()),
+		"untagged_vlan": int(untaggedVlan.GetId()),
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDeviceInterfaces = "" +
+	"netbox_dcim_device_interfaces.test"
+
+func TestAccNetboxDcimDeviceInterfacesMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceInterfaces),
+				),
+			},
+			{
+				ResourceName:            resourceNameNetboxDcimDeviceInterfaces,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_unmanaged"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceInterfacesFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceInterfaces),
+				),
+			},
+			{
+				ResourceName:            resourceNameNetboxDcimDeviceInterfaces,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_unmanaged"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceInterfacesMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceInterfaces),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceInterfaces),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceInterfaces),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceInterfaces),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceInterfacesConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_ipam_vlan" "test" {
+		vlan_id = 100
+		name    = "dcimdevintf-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimdevintf-{{ .namesuffix }}"
+		slug = "dcimdevintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimdevintf-{{ .namesuffix }}"
+		slug            = "dcimdevintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimdevintf-{{ .namesuffix }}"
+		slug = "dcimdevintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimdevintf-{{ .namesuffix }}"
+		slug = "dcimdevintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimdevintf-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_device_interfaces" "test" {
+		device_id = netbox_dcim_device.test.id
+
+		interface {
+			name = "eth0"
+			type = "1000base-t"
+		}
+
+		{{ if eq .resourcefull "true" }}
+		delete_unmanaged = true
+
+		interface {
+			description    = "Test interface"
+			enabled        = false
+			label          = "Port 2"
+			mac_address    = "AA:AA:AA:AA:AA:AA"
+			mark_connected = true
+			mgmt_only      = true
+			mode           = "tagged"
+			mtu            = 1500
+			name           = "eth1"
+			speed          = 1000000
+			tagged_vlans   = [netbox_ipam_vlan.test.id]
+			type           = "1000base-t"
+			untagged_vlan  = netbox_ipam_vlan.test.id
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
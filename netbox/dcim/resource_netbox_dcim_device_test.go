@@ -0,0 +1,289 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDevice = "netbox_dcim_device.test"
+
+func TestAccNetboxDcimDeviceMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDevice,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDevice,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_platform" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_location" "test" {
+		name    = "dcimdevice-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+		slug    = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_rack" "test" {
+		name        = "dcimdevice-{{ .namesuffix }}"
+		site_id     = netbox_dcim_site.test.id
+		location_id = netbox_dcim_location.test.id
+		height      = 10
+		width       = 19
+	}
+	{{ end }}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimdevice-{{ .namesuffix }}"
+		slug            = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimdevice-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+
+		{{ if eq .resourcefull "true" }}
+		airflow = "front-to-rear"
+		asset_tag = "dcimdevice-{{ .namesuffix }}"
+		comments = <<-EOT
+		Comments for Test Device
+		Multiline
+		EOT
+		description = "Test device"
+		face = "front"
+		local_context_data = jsonencode(
+			{
+			hello = "world"
+			number = 1
+			}
+		)
+		location_id = netbox_dcim_location.test.id
+		platform_id = netbox_dcim_platform.test.id
+		position = 1
+		rack_id = netbox_dcim_rack.test.id
+		serial = "dcimdevice-{{ .namesuffix }}"
+		status = "planned"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
+
+func TestAccNetboxDcimDeviceRackPlacement(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceRackPlacementConfig(
+					nameSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+					resource.TestCheckResourceAttr(
+						resourceNameNetboxDcimDevice, "position", "9"),
+					resource.TestCheckResourceAttr(
+						resourceNameNetboxDcimDevice, "face", "rear"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceRackPlacementConfig(
+					nameSuffix),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceNameNetboxDcimDevice,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rack_placement"},
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceRackPlacementConfig(
+	nameSuffix string) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimdevice-{{ .namesuffix }}"
+		slug            = "dcimdevice-{{ .namesuffix }}"
+		u_height        = 2
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimdevice-{{ .namesuffix }}"
+		slug = "dcimdevice-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_rack" "test" {
+		name    = "dcimdevice-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+		height  = 10
+		width   = 19
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimdevice-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+
+		rack_placement {
+			face       = "rear"
+			preference = "highest"
+			rack_id    = netbox_dcim_rack.test.id
+			u_height   = 2
+		}
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,564 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+var deviceTypeTemplates = []string{
+	"console_port_template",
+	"front_port_template",
+	"interface_template",
+	"module_bay_template",
+	"power_port_template",
+	"rear_port_template",
+}
+
+func ResourceNetboxDcimDeviceType() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a device type within Netbox.",
+		CreateContext: resourceNetboxDcimDeviceTypeCreate,
+		ReadContext:   resourceNetboxDcimDeviceTypeRead,
+		UpdateContext: resourceNetboxDcimDeviceTypeUpdate,
+		DeleteContext: resourceNetboxDcimDeviceTypeDelete,
+		Exists:        resourceNetboxDcimDeviceTypeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"airflow": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"front-to-rear", "rear-to-front", "left-to-right",
+					"right-to-left", "side-to-rear", "passive", "mixed"},
+					false),
+				Description: "The airflow among front-to-rear, " +
+					"rear-to-front, left-to-right, right-to-left, " +
+					"side-to-rear, passive or mixed of this device type.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this device type.",
+			},
+			"console_port_template": consolePortTemplateSchema(),
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this device type.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device type was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"default_platform_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The platform assigned by default to the " +
+					"devices of this device type.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this device type.",
+			},
+			"device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices of this device type.",
+			},
+			"exclude_from_utilization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Devices of this device type are excluded " +
+					"when calculating rack utilization.",
+			},
+			"front_port_template": frontPortTemplateSchema(),
+			"interface_template":  interfaceTemplateSchema(),
+			"is_full_depth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Devices of this device type consume both " +
+					"front and rear rack faces.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device type was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The manufacturer of this device type.",
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The model of this device type.",
+			},
+			"module_bay_template": moduleBayTemplateSchema(),
+			"part_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "The part number of this device type.",
+			},
+			"power_port_template": powerPortTemplateSchema(),
+			"rear_port_template":  rearPortTemplateSchema(),
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The slug of this device type.",
+			},
+			"subdevice_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"parent",
+					"child"}, false),
+				Description: "The subdevice role among parent or child of " +
+					"this device type.",
+			},
+			"tag": &tag.TagSchema,
+			"u_height": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The height in rack units of this device type.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this device type.",
+			},
+			"weight": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The weight of this device type.",
+			},
+			"weight_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"weight"},
+				ValidateFunc: validation.StringInSlice([]string{"kg", "g",
+					"lb", "oz"}, false),
+				Description: "The unit among kg, g, lb or oz of the weight " +
+					"of this device type.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimDeviceTypeCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	manufacturer, errDiag := brief.GetBriefManufacturerRequestFromID(ctx,
+		client, d.Get("manufacturer_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewWritableDeviceTypeRequestWithDefaults()
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetExcludeFromUtilization(
+		d.Get("exclude_from_utilization").(bool))
+	newResource.SetIsFullDepth(d.Get("is_full_depth").(bool))
+	newResource.SetManufacturer(*manufacturer)
+	newResource.SetModel(d.Get("model").(string))
+	newResource.SetPartNumber(d.Get("part_number").(string))
+	newResource.SetSlug(d.Get("slug").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	newResource.SetUHeight(d.Get("u_height").(float64))
+
+	if airflow := d.Get("airflow").(string); airflow != "" {
+		a, err := netbox.NewDeviceAirflowValueFromValue(airflow)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetAirflow(*a)
+	}
+
+	if platformID := d.Get("default_platform_id").(int); platformID != 0 {
+		b, err := brief.GetBriefPlatformRequestFromID(ctx, client, platformID)
+		if err != nil {
+			return err
+		}
+		newResource.SetDefaultPlatform(*b)
+	}
+
+	if subdeviceRole := d.Get("subdevice_role").(string); subdeviceRole != "" {
+		s, err := netbox.NewParentChildStatus1FromValue(subdeviceRole)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetSubdeviceRole(*s)
+	}
+
+	if weight, ok := d.GetOk("weight"); ok {
+		newResource.SetWeight(weight.(float64))
+	}
+
+	if weightUnit := d.Get("weight_unit").(string); weightUnit != "" {
+		w, err := netbox.NewDeviceTypeWeightUnitValueFromValue(weightUnit)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetWeightUnit(*w)
+	}
+
+	_, response, err := client.DcimAPI.DcimDeviceTypesCreate(
+		ctx).WritableDeviceTypeRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+
+	parent, errDiag := newDeviceTypeTemplateParent(ctx, client,
+		int(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if errDiag := reconcileComponentTemplates(ctx, client, d, parent,
+		deviceTypeTemplates); errDiag != nil {
+		return errDiag
+	}
+
+	return resourceNetboxDcimDeviceTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceTypeRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimDeviceTypesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("airflow", resource.GetAirflow().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("default_platform_id",
+		resource.GetDefaultPlatform().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_count", resource.GetDeviceCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("exclude_from_utilization",
+		resource.GetExcludeFromUtilization()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("is_full_depth", resource.GetIsFullDepth()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("manufacturer_id",
+		resource.GetManufacturer().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("model", resource.GetModel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("part_number", resource.GetPartNumber()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("slug", resource.GetSlug()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("subdevice_role",
+		resource.GetSubdeviceRole().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("u_height", resource.GetUHeight()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("weight", resource.GetWeight()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("weight_unit",
+		resource.GetWeightUnit().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	parent := &templateParent{id: resource.GetId()}
+	return readComponentTemplates(ctx, client, d, parent,
+		deviceTypeTemplates)
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimDeviceTypeUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableDeviceTypeRequestWithDefaults()
+
+	// Required fields
+	manufacturer, errDiag := brief.GetBriefManufacturerRequestFromID(ctx,
+		client, d.Get("manufacturer_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetManufacturer(*manufacturer)
+	resource.SetModel(d.Get("model").(string))
+	resource.SetSlug(d.Get("slug").(string))
+
+	if d.HasChange("airflow") {
+		a, err := netbox.NewDeviceAirflowValueFromValue(
+			d.Get("airflow").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAirflow(*a)
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("default_platform_id") {
+		if platformID := d.Get("default_platform_id").(int); platformID != 0 {
+			b, err := brief.GetBriefPlatformRequestFromID(ctx, client,
+				platformID)
+			if err != nil {
+				return err
+			}
+			resource.SetDefaultPlatform(*b)
+		} else {
+			resource.SetDefaultPlatformNil()
+		}
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("exclude_from_utilization") {
+		resource.SetExcludeFromUtilization(
+			d.Get("exclude_from_utilization").(bool))
+	}
+
+	if d.HasChange("is_full_depth") {
+		resource.SetIsFullDepth(d.Get("is_full_depth").(bool))
+	}
+
+	if d.HasChange("part_number") {
+		resource.SetPartNumber(d.Get("part_number").(string))
+	}
+
+	if d.HasChange("subdevice_role") {
+		s, err := netbox.NewParentChildStatus1FromValue(
+			d.Get("subdevice_role").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetSubdeviceRole(*s)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("u_height") {
+		resource.SetUHeight(d.Get("u_height").(float64))
+	}
+
+	if d.HasChange("weight") {
+		if weight, ok := d.GetOk("weight"); ok {
+			resource.SetWeight(weight.(float64))
+		} else {
+			resource.SetWeightNil()
+		}
+	}
+
+	if d.HasChange("weight_unit") {
+		w, err := netbox.NewDeviceTypeWeightUnitValueFromValue(
+			d.Get("weight_unit").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetWeightUnit(*w)
+	}
+
+	if _, response, err := client.DcimAPI.DcimDeviceTypesUpdate(ctx,
+		int32(resourceID)).WritableDeviceTypeRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	parent, errDiag := newDeviceTypeTemplateParent(ctx, client,
+		int(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if errDiag := reconcileComponentTemplates(ctx, client, d, parent,
+		deviceTypeTemplates); errDiag != nil {
+		return errDiag
+	}
+
+	return resourceNetboxDcimDeviceTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceTypeDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimDeviceTypeExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimDeviceTypesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimDeviceTypesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
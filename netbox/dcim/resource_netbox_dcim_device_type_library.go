@@ -0,0 +1,449 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimDeviceTypeLibrary() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage a device type within Netbox from a " +
+			"definition of the devicetype-library " +
+			"(https://github.com/netbox-community/devicetype-library). " +
+			"The manufacturer is created if it does not exist yet and is " +
+			"kept when the device type is deleted. The definition is read " +
+			"back from Netbox so that the changes made outside of " +
+			"Terraform are detected.",
+		CreateContext: resourceNetboxDcimDeviceTypeLibraryCreate,
+		ReadContext:   resourceNetboxDcimDeviceTypeLibraryRead,
+		UpdateContext: resourceNetboxDcimDeviceTypeLibraryUpdate,
+		DeleteContext: resourceNetboxDcimDeviceTypeLibraryDelete,
+		Exists:        resourceNetboxDcimDeviceTypeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this device type.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device type was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"definition_yaml": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateDeviceTypeLibrary,
+				DiffSuppressFunc: diffSuppressDeviceTypeLibrary,
+				Description: "The definition of this device type in the " +
+					"YAML format of the devicetype-library. The state " +
+					"contains the definition as read from Netbox with the " +
+					"default values applied and the components sorted by " +
+					"name, the unsupported keys are not kept.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device type was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The manufacturer of this device type.",
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The model of this device type.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The slug of this device type.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this device type.",
+			},
+		},
+	}
+}
+
+func validateDeviceTypeLibrary(v any, path cty.Path) diag.Diagnostics {
+	_, ignoredKeys, err := parseDeviceTypeLibrary(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid devicetype-library definition",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	if len(ignoredKeys) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unsupported devicetype-library keys",
+			Detail: "The following keys are ignored: " +
+				strings.Join(ignoredKeys, ", "),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// Two definitions are the same when their normalized forms are equal, the
+// order of the keys and of the components and the default values do not
+// matter
+func diffSuppressDeviceTypeLibrary(_, old, new string,
+	_ *schema.ResourceData) bool {
+
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldDefinition, err := normalizeDeviceTypeLibrary(old)
+	if err != nil {
+		return false
+	}
+
+	newDefinition, err := normalizeDeviceTypeLibrary(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDefinition == newDefinition
+}
+
+// readDeviceTypeLibrary rebuilds the normalized definition of a device type
+// from Netbox.
+func readDeviceTypeLibrary(ctx context.Context, client *netbox.APIClient,
+	resource *netbox.DeviceType) (string, diag.Diagnostics) {
+
+	airflow := resource.GetAirflow()
+	subdeviceRole := resource.GetSubdeviceRole()
+	weightUnit := resource.GetWeightUnit()
+
+	definition := map[string]any{
+		"airflow":                  string(airflow.GetValue()),
+		"comments":                 resource.GetComments(),
+		"description":              resource.GetDescription(),
+		"exclude_from_utilization": resource.GetExcludeFromUtilization(),
+		"is_full_depth":            resource.GetIsFullDepth(),
+		"manufacturer":             resource.Manufacturer.GetName(),
+		"model":                    resource.GetModel(),
+		"part_number":              resource.GetPartNumber(),
+		"slug":                     resource.GetSlug(),
+		"subdevice_role":           string(subdeviceRole.GetValue()),
+		"u_height":                 resource.GetUHeight(),
+		"weight_unit":              string(weightUnit.GetValue()),
+	}
+
+	if weight, ok := resource.GetWeightOk(); ok && weight != nil {
+		definition["weight"] = *weight
+	}
+
+	parent := &templateParent{id: resource.GetId()}
+	for section, key := range librarySections {
+		components, errDiag := componentTemplates[key].list(ctx, client,
+			parent)
+		if errDiag != nil {
+			return "", errDiag
+		}
+		setLibraryComponents(definition, section, components)
+	}
+
+	yaml, err := marshalDeviceTypeLibrary(definition)
+	if err != nil {
+		return "", util.GenerateErrorMessage(nil, err)
+	}
+
+	return yaml, nil
+}
+
+func getOrCreateManufacturer(ctx context.Context, client *netbox.APIClient,
+	name string) (*netbox.BriefManufacturerRequest, diag.Diagnostics) {
+
+	resources, response, err := client.DcimAPI.DcimManufacturersList(
+		ctx).Name([]string{name}).Execute()
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	if resources.GetCount() > 0 {
+		m := resources.Results[0]
+		return netbox.NewBriefManufacturerRequest(m.GetName(), m.GetSlug()),
+			nil
+	}
+
+	newResource := netbox.NewManufacturerRequest(name, librarySlug(name))
+	m, response, err := client.DcimAPI.DcimManufacturersCreate(
+		ctx).ManufacturerRequest(*newResource).Execute()
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	return netbox.NewBriefManufacturerRequest(m.GetName(), m.GetSlug()), nil
+}
+
+func newDeviceTypeRequestFromLibrary(ctx context.Context,
+	client *netbox.APIClient, t *libraryDeviceType) (
+	*netbox.WritableDeviceTypeRequest, diag.Diagnostics) {
+
+	manufacturer, errDiag := getOrCreateManufacturer(ctx, client,
+		t.Manufacturer)
+	if errDiag != nil {
+		return nil, errDiag
+	}
+
+	request := netbox.NewWritableDeviceTypeRequestWithDefaults()
+	request.SetComments(t.Comments)
+	request.SetDescription(t.Description)
+	request.SetExcludeFromUtilization(t.ExcludeFromUtilization)
+	request.SetManufacturer(*manufacturer)
+	request.SetModel(t.Model)
+	request.SetPartNumber(t.PartNumber)
+	request.SetSlug(t.Slug)
+
+	isFullDepth := true
+	if t.IsFullDepth != nil {
+		isFullDepth = *t.IsFullDepth
+	}
+	request.SetIsFullDepth(isFullDepth)
+
+	uHeight := 1.0
+	if t.UHeight != nil {
+		uHeight = *t.UHeight
+	}
+	request.SetUHeight(uHeight)
+
+	if t.Weight != nil {
+		request.SetWeight(*t.Weight)
+	} else {
+		request.SetWeightNil()
+	}
+
+	// The values have already been validated by parseDeviceTypeLibrary
+	airflow, _ := netbox.NewDeviceAirflowValueFromValue(t.Airflow)
+	request.SetAirflow(*airflow)
+
+	subdeviceRole, _ := netbox.NewParentChildStatus1FromValue(t.SubdeviceRole)
+	request.SetSubdeviceRole(*subdeviceRole)
+
+	weightUnit, _ := netbox.NewDeviceTypeWeightUnitValueFromValue(
+		t.WeightUnit)
+	request.SetWeightUnit(*weightUnit)
+
+	return request, nil
+}
+
+func resourceNetboxDcimDeviceTypeLibraryCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	definition, _, err := parseDeviceTypeLibrary(
+		d.Get("definition_yaml").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource, errDiag := newDeviceTypeRequestFromLibrary(ctx, client,
+		definition)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	newResource.SetCustomFields(customFields)
+	tags := d.Get("tag").(*schema.Set).List()
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	_, response, err := client.DcimAPI.DcimDeviceTypesCreate(
+		ctx).WritableDeviceTypeRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+
+	parent, errDiag := newDeviceTypeTemplateParent(ctx, client,
+		int(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if errDiag := applyComponentTemplates(ctx, client, parent,
+		definition.templates()); errDiag != nil {
+		return errDiag
+	}
+
+	return resourceNetboxDcimDeviceTypeLibraryRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceTypeLibraryRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimDeviceTypesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	definition, errDiag := readDeviceTypeLibrary(ctx, client, resource)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if err = d.Set("definition_yaml", definition); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("manufacturer_id",
+		resource.GetManufacturer().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("model", resource.GetModel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("slug", resource.GetSlug()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeLibraryUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	definition, _, err := parseDeviceTypeLibrary(
+		d.Get("definition_yaml").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, errDiag := newDeviceTypeRequestFromLibrary(ctx, client,
+		definition)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.DcimAPI.DcimDeviceTypesUpdate(ctx,
+		int32(resourceID)).WritableDeviceTypeRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if d.HasChange("definition_yaml") {
+		parent, errDiag := newDeviceTypeTemplateParent(ctx, client,
+			int(resourceID))
+		if errDiag != nil {
+			return errDiag
+		}
+
+		if errDiag := applyComponentTemplates(ctx, client, parent,
+			definition.templates()); errDiag != nil {
+			return errDiag
+		}
+	}
+
+	return resourceNetboxDcimDeviceTypeLibraryRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceTypeLibraryDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	return resourceNetboxDcimDeviceTypeDelete(ctx, d, m)
+}
@@ -0,0 +1,179 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDeviceTypeLibrary = "" +
+	"netbox_dcim_device_type_library.test"
+
+func TestAccNetboxDcimDeviceTypeLibraryMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceTypeLibrary),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDeviceTypeLibrary,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeLibraryFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceTypeLibrary),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDeviceTypeLibrary,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeLibraryMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceTypeLibrary),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceTypeLibrary),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceTypeLibrary),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceTypeLibrary),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceTypeLibraryConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimdevicetypelibrary-{{ .namesuffix }}"
+		slug = "dcimdevicetypelibrary-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_device_type_library" "test" {
+		{{ if eq .resourcefull "true" }}
+		definition_yaml = <<-EOT
+		manufacturer: dcimdevicetypelibrary-{{ .namesuffix }}
+		model: dcimdevicetypelibrary-{{ .namesuffix }}
+		slug: dcimdevicetypelibrary-{{ .namesuffix }}
+		part_number: dcimdevicetypelibrary-{{ .namesuffix }}
+		u_height: 2
+		is_full_depth: false
+		airflow: front-to-rear
+		weight: 10.5
+		weight_unit: kg
+		console-ports:
+		  - name: console
+		    type: rj-45
+		power-ports:
+		  - name: PSU0
+		    type: iec-60320-c14
+		    maximum_draw: 500
+		interfaces:
+		  - name: eth0
+		    type: 1000base-t
+		    mgmt_only: true
+		  - name: eth1
+		    type: 10gbase-x-sfpp
+		rear-ports:
+		  - name: rear0
+		    type: lc
+		    positions: 2
+		front-ports:
+		  - name: front0
+		    type: lc
+		    rear_port: rear0
+		    rear_port_position: 1
+		  - name: front1
+		    type: lc
+		    rear_port: rear0
+		    rear_port_position: 2
+		module-bays:
+		  - name: slot1
+		    position: "1"
+		EOT
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ else }}
+		definition_yaml = <<-EOT
+		manufacturer: dcimdevicetypelibrary-{{ .namesuffix }}
+		model: dcimdevicetypelibrary-{{ .namesuffix }}
+		slug: dcimdevicetypelibrary-{{ .namesuffix }}
+		EOT
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,211 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDeviceType = "netbox_dcim_device_type.test"
+
+func TestAccNetboxDcimDeviceTypeMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDeviceType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDeviceType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimdevicetype-{{ .namesuffix }}"
+		slug = "dcimdevicetype-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_platform" "test" {
+		name = "dcimdevicetype-{{ .namesuffix }}"
+		slug = "dcimdevicetype-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimdevicetype-{{ .namesuffix }}"
+		slug = "dcimdevicetype-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimdevicetype-{{ .namesuffix }}"
+		slug            = "dcimdevicetype-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		airflow = "front-to-rear"
+		comments = <<-EOT
+		Comments for Test Device Type
+		Multiline
+		EOT
+		default_platform_id = netbox_dcim_platform.test.id
+		description = "Test device type"
+		exclude_from_utilization = true
+		is_full_depth = false
+		part_number = "dcimdevicetype-{{ .namesuffix }}"
+		subdevice_role = "parent"
+		u_height = 2
+		weight = 10.5
+		weight_unit = "kg"
+
+		console_port_template {
+			name = "console"
+			type = "rj-45"
+		}
+
+		interface_template {
+			name = "eth0"
+			type = "1000base-t"
+			mgmt_only = true
+		}
+
+		interface_template {
+			name = "eth1"
+			type = "10gbase-x-sfpp"
+			description = "Uplink"
+			poe_mode = "pse"
+			poe_type = "type1-ieee802.3af"
+		}
+
+		module_bay_template {
+			name = "bay1"
+			position = "1"
+		}
+
+		power_port_template {
+			name = "psu0"
+			type = "iec-60320-c14"
+			maximum_draw = 500
+			allocated_draw = 250
+		}
+
+		rear_port_template {
+			name = "rear0"
+			type = "8p8c"
+			positions = 2
+		}
+
+		front_port_template {
+			name = "front0"
+			type = "8p8c"
+			rear_port = "rear0"
+			rear_port_position = 1
+		}
+
+		front_port_template {
+			name = "front1"
+			type = "8p8c"
+			rear_port = "rear0"
+			rear_port_position = 2
+		}
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,456 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimFrontPort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a front port of a device within Netbox.",
+		CreateContext: resourceNetboxDcimFrontPortCreate,
+		ReadContext:   resourceNetboxDcimFrontPortRead,
+		UpdateContext: resourceNetboxDcimFrontPortUpdate,
+		DeleteContext: resourceNetboxDcimFrontPortDelete,
+		Exists:        resourceNetboxDcimFrontPortExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(colorRegexp,
+					"Must be like 00ff00"),
+				Description: "The color of this front port (hexadecimal).",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this front port.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this front port was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this front port.",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the device of this front port.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const64),
+				Description:  "The physical label of this front port.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this front port was last updated.",
+			},
+			"mark_connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Treat this front port as if a cable is " +
+					"connected.",
+			},
+			"module_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the module of this front port.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this front port.",
+			},
+			"rear_port_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the rear port mapped to this front " +
+					"port.",
+			},
+			"rear_port_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, maxRearPortPositions),
+				Description: "The position on the rear port mapped to this " +
+					"front port (1 by default), it cannot exceed the " +
+					"positions of the rear port.",
+			},
+			"tag": &tag.TagSchema,
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedFrontPortTypeValueEnumValues), false),
+				Description: "The type of this front port (8p8c, lc, sc, ...).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this front port.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimFrontPortCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	t, err := netbox.NewFrontPortTypeValueFromValue(d.Get("type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	rearPortID, err := safecast.ToInt32(d.Get("rear_port_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	rearPortPosition, err := safecast.ToInt32(
+		d.Get("rear_port_position").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if errDiag := checkFrontPortRearPortPosition(ctx, client, rearPortID,
+		rearPortPosition); errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewWritableFrontPortRequest(*b,
+		d.Get("name").(string), *t, rearPortID)
+	newResource.SetColor(d.Get("color").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetLabel(d.Get("label").(string))
+	newResource.SetMarkConnected(d.Get("mark_connected").(bool))
+	newResource.SetRearPortPosition(rearPortPosition)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if moduleID := d.Get("module_id").(int); moduleID != 0 {
+		b, err := brief.GetBriefModuleRequestFromID(ctx, client, moduleID)
+		if err != nil {
+			return err
+		}
+		newResource.SetModule(*b)
+	}
+
+	_, response, err := client.DcimAPI.DcimFrontPortsCreate(
+		ctx).WritableFrontPortRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimFrontPortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimFrontPortRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimFrontPortsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("color", resource.GetColor()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_id", resource.GetDevice().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("label", resource.GetLabel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mark_connected", resource.GetMarkConnected()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("module_id", resource.GetModule().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rear_port_id", resource.GetRearPort().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rear_port_position",
+		resource.GetRearPortPosition()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("type", resource.GetType().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableFrontPortRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetDevice(*b)
+	resource.SetName(d.Get("name").(string))
+
+	t, err := netbox.NewFrontPortTypeValueFromValue(d.Get("type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetType(*t)
+
+	rearPortID, err := safecast.ToInt32(d.Get("rear_port_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetRearPort(rearPortID)
+
+	if d.HasChange("color") {
+		resource.SetColor(d.Get("color").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("label") {
+		resource.SetLabel(d.Get("label").(string))
+	}
+
+	if d.HasChange("mark_connected") {
+		resource.SetMarkConnected(d.Get("mark_connected").(bool))
+	}
+
+	if d.HasChange("module_id") {
+		if moduleID := d.Get("module_id").(int); moduleID != 0 {
+			b, err := brief.GetBriefModuleRequestFromID(ctx, client, moduleID)
+			if err != nil {
+				return err
+			}
+			resource.SetModule(*b)
+		} else {
+			resource.SetModuleNil()
+		}
+	}
+
+	if d.HasChanges("rear_port_id", "rear_port_position") {
+		rearPortPosition, err := safecast.ToInt32(
+			d.Get("rear_port_position").(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+
+		if errDiag := checkFrontPortRearPortPosition(ctx, client, rearPortID,
+			rearPortPosition); errDiag != nil {
+			return errDiag
+		}
+		resource.SetRearPortPosition(rearPortPosition)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.DcimAPI.DcimFrontPortsUpdate(ctx,
+		int32(resourceID)).WritableFrontPortRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimFrontPortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimFrontPortDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimFrontPortExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimFrontPortsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimFrontPortsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// checkFrontPortRearPortPosition checks that the rear port has the position
+// mapped to the front port. It is done at apply time as the rear port may be
+// created or changed in the same plan.
+func checkFrontPortRearPortPosition(ctx context.Context,
+	client *netbox.APIClient, rearPortID int32,
+	position int32) diag.Diagnostics {
+
+	rearPort, response, err := client.DcimAPI.DcimRearPortsRetrieve(ctx,
+		rearPortID).Execute()
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if positions := rearPort.GetPositions(); position > positions {
+		return util.GenerateErrorMessage(nil,
+			fmt.Errorf("rear_port_position (%d) exceeds the positions of "+
+				"the rear port %s (%d)", position, rearPort.GetName(),
+				positions))
+	}
+
+	return nil
+}
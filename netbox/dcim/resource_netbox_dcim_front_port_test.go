@@ -0,0 +1,192 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimFrontPort = "netbox_dcim_front_port.test"
+
+func TestAccNetboxDcimFrontPortMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimFrontPortConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimFrontPort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimFrontPort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimFrontPortFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimFrontPortConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimFrontPort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimFrontPort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimFrontPortMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimFrontPortConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimFrontPort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimFrontPortConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimFrontPort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimFrontPortConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimFrontPort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimFrontPortConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimFrontPort),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimFrontPortConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimfrontport-{{ .namesuffix }}"
+		slug = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimfrontport-{{ .namesuffix }}"
+		slug            = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimfrontport-{{ .namesuffix }}"
+		slug = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimfrontport-{{ .namesuffix }}"
+		slug = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimfrontport-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimfrontport-{{ .namesuffix }}"
+		slug = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module_bay" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimfrontport-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module" "test" {
+		device_id      = netbox_dcim_device.test.id
+		module_bay_id  = netbox_dcim_module_bay.test.id
+		module_type_id = netbox_dcim_module_type.test.id
+	}
+	{{ end }}
+
+	resource "netbox_dcim_rear_port" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimfrontport-{{ .namesuffix }}"
+		positions = 2
+		type      = "lc"
+	}
+
+	resource "netbox_dcim_front_port" "test" {
+		device_id    = netbox_dcim_device.test.id
+		name         = "dcimfrontport-{{ .namesuffix }}"
+		rear_port_id = netbox_dcim_rear_port.test.id
+		type         = "lc"
+
+		{{ if eq .resourcefull "true" }}
+		color = "00ff00"
+		description = "Test front port"
+		label = "FP1"
+		mark_connected = true
+		module_id = netbox_dcim_module.test.id
+		rear_port_position = 2
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
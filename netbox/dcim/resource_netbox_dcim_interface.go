@@ -0,0 +1,930 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimInterface() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an interface of a device within Netbox.",
+		CreateContext: resourceNetboxDcimInterfaceCreate,
+		ReadContext:   resourceNetboxDcimInterfaceRead,
+		UpdateContext: resourceNetboxDcimInterfaceUpdate,
+		DeleteContext: resourceNetboxDcimInterfaceDelete,
+		Exists:        resourceNetboxDcimInterfaceExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bridge_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the bridge interface where this " +
+					"interface is attached to.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this interface.",
+			},
+			"count_ipaddresses": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Number of ip addresses attached to this " +
+					"interface.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this interface was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this interface.",
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the device where this interface " +
+					"is attached to.",
+			},
+			"duplex": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"half",
+					"full", "auto"}, false),
+				Description: "The duplex among half, full or auto of this " +
+					"interface.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Is this interface enabled (true by default).",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const64),
+				Description:  "The physical label of this interface.",
+			},
+			"lag_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the LAG interface where this " +
+					"interface is a member of.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this interface was last updated.",
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^([A-Z0-9]{2}:){5}[A-Z0-9]{2}$"),
+					"Must be like AA:AA:AA:AA:AA"),
+				Description: "Mac address of this interface.",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Treat this interface as if a cable is connected.",
+			},
+			"mgmt_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Is this interface only used for out-of-band " +
+					"management.",
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"access",
+					"tagged", "tagged-all"}, false),
+				Description: "The mode among access, tagged, tagged-all of " +
+					"this interface.",
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, util.Const65536),
+				Description: "The MTU between 1 and 65536 of this " +
+					"interface.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this interface.",
+			},
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the parent interface where " +
+					"this interface is attached to.",
+			},
+			"poe_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedInterfacePoeModeValueEnumValues),
+					false),
+				Description: "The PoE mode among pd or pse of this " +
+					"interface.",
+			},
+			"poe_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedInterfacePoeTypeValueEnumValues),
+					false),
+				Description: "The PoE type of this interface.",
+			},
+			"rf_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedWirelessChannelEnumValues),
+					false),
+				Description: "The wireless channel of this interface.",
+			},
+			"rf_channel_frequency": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+				Description: "The channel frequency (MHz) of this " +
+					"interface, populated by the channel if set.",
+			},
+			"rf_channel_width": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+				Description: "The channel width (MHz) of this interface, " +
+					"populated by the channel if set.",
+			},
+			"rf_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"ap",
+					"station"}, false),
+				Description: "The wireless role among ap or station of " +
+					"this interface.",
+			},
+			"speed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The speed (Kbps) of this interface.",
+			},
+			"tag": &tag.TagSchema,
+			"tagged_vlans": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "One of the vlan id tagged for this interface",
+				},
+				Optional:    true,
+				Description: "List of vlan id tagged for this interface.",
+			},
+			"tx_power": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, util.Const127),
+				Description:  "The transmit power (dBm) of this interface.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedInterfaceTypeValueEnumValues),
+					false),
+				Description: "The type of this interface " +
+					"(virtual, 1000base-t, 10gbase-x-sfpp, ...).",
+			},
+			"untagged_vlan": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Vlan ID untagged for this interface.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this interface.",
+			},
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the VRF where this interface " +
+					"is attached to.",
+			},
+			"wireless_lans": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+					Description: "One of the wireless LAN id of this " +
+						"interface",
+				},
+				Optional:    true,
+				Description: "List of wireless LAN id of this interface.",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimInterfaceCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	taggedVlans, err :=
+		util.ExpandToInt32Slice(d.Get("tagged_vlans").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	tags := d.Get("tag").(*schema.Set).List()
+	wirelessLans, err :=
+		util.ExpandToInt32Slice(d.Get("wireless_lans").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	interfaceType, err := netbox.NewInterfaceTypeValueFromValue(
+		d.Get("type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableInterfaceRequest(*b,
+		d.Get("name").(string), *interfaceType)
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetEnabled(d.Get("enabled").(bool))
+	newResource.SetLabel(d.Get("label").(string))
+	newResource.SetMarkConnected(d.Get("mark_connected").(bool))
+	newResource.SetMgmtOnly(d.Get("mgmt_only").(bool))
+	newResource.SetTaggedVlans(taggedVlans)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	newResource.SetWirelessLans(wirelessLans)
+
+	mode, err := netbox.NewPatchedWritableInterfaceRequestModeFromValue(
+		d.Get("mode").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetMode(*mode)
+
+	poeMode, err := netbox.NewInterfacePoeModeValueFromValue(
+		d.Get("poe_mode").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetPoeMode(*poeMode)
+
+	poeType, err := netbox.NewInterfacePoeTypeValueFromValue(
+		d.Get("poe_type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetPoeType(*poeType)
+
+	if bridgeID := d.Get("bridge_id").(int); bridgeID != 0 {
+		bridgeID32, err := safecast.ToInt32(bridgeID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetBridge(bridgeID32)
+	}
+
+	if duplex := d.Get("duplex").(string); duplex != "" {
+		dp, err := netbox.NewInterfaceRequestDuplexFromValue(duplex)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetDuplex(*dp)
+	}
+
+	if lagID := d.Get("lag_id").(int); lagID != 0 {
+		lagID32, err := safecast.ToInt32(lagID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetLag(lagID32)
+	}
+
+	if macAddress := d.Get("mac_address").(string); macAddress != "" {
+		newResource.SetMacAddress(macAddress)
+	}
+
+	if mtu := d.Get("mtu").(int); mtu != 0 {
+		mtu32, err := safecast.ToInt32(mtu)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetMtu(mtu32)
+	}
+
+	if parentID := d.Get("parent_id").(int); parentID != 0 {
+		parentID32, err := safecast.ToInt32(parentID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetParent(parentID32)
+	}
+
+	if rfChannel := d.Get("rf_channel").(string); rfChannel != "" {
+		c, err := netbox.NewWirelessChannelFromValue(rfChannel)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetRfChannel(*c)
+	}
+
+	if frequency, ok := d.GetOk("rf_channel_frequency"); ok {
+		newResource.SetRfChannelFrequency(frequency.(float64))
+	}
+
+	if width, ok := d.GetOk("rf_channel_width"); ok {
+		newResource.SetRfChannelWidth(width.(float64))
+	}
+
+	if rfRole := d.Get("rf_role").(string); rfRole != "" {
+		r, err := netbox.NewWirelessRoleFromValue(rfRole)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetRfRole(*r)
+	}
+
+	if speed := d.Get("speed").(int); speed != 0 {
+		speed32, err := safecast.ToInt32(speed)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetSpeed(speed32)
+	}
+
+	if txPower, ok := d.GetOk("tx_power"); ok {
+		txPower32, err := safecast.ToInt32(txPower.(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetTxPower(txPower32)
+	}
+
+	if untaggedVlan := d.Get("untagged_vlan").(int); untaggedVlan != 0 {
+		b, err := brief.GetBriefVLANRequestFromID(ctx, client, untaggedVlan)
+		if err != nil {
+			return err
+		}
+		newResource.SetUntaggedVlan(*b)
+	}
+
+	if vrfID := d.Get("vrf_id").(int); vrfID != 0 {
+		b, err := brief.GetBriefVRFRequestFromID(ctx, client, vrfID)
+		if err != nil {
+			return err
+		}
+		newResource.SetVrf(*b)
+	}
+
+	_, response, err := client.DcimAPI.DcimInterfacesCreate(
+		ctx).WritableInterfaceRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimInterfaceRead(ctx, d, m)
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimInterfaceRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := util.DecodeInterfaceResponse(
+		client.DcimAPI.DcimInterfacesRetrieve(ctx, int32(resourceID)).Execute())
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("bridge_id", resource.GetBridge().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("count_ipaddresses",
+		resource.GetCountIpaddresses()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_id", resource.GetDevice().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("duplex", resource.GetDuplex().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("enabled", resource.GetEnabled()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("label", resource.GetLabel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("lag_id", resource.GetLag().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mac_address", resource.GetMacAddress()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mark_connected", resource.GetMarkConnected()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mgmt_only", resource.GetMgmtOnly()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mode", resource.GetMode().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mtu", resource.GetMtu()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("parent_id", resource.GetParent().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("poe_mode", resource.GetPoeMode().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("poe_type", resource.GetPoeType().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rf_channel", resource.GetRfChannel().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rf_channel_frequency",
+		resource.GetRfChannelFrequency()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rf_channel_width",
+		resource.GetRfChannelWidth()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rf_role", resource.GetRfRole().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("speed", resource.GetSpeed()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tagged_vlans", util.ConvertAPIVlansToVlans(
+		resource.GetTaggedVlans())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tx_power", resource.GetTxPower()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("type", resource.GetType().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("untagged_vlan", resource.GetUntaggedVlan().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("vrf_id", resource.GetVrf().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	var wirelessLans []int32
+	for _, w := range resource.GetWirelessLans() {
+		wirelessLans = append(wirelessLans, w.GetId())
+	}
+
+	if err = d.Set("wireless_lans", wirelessLans); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimInterfaceUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableInterfaceRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetDevice(*b)
+	resource.SetName(d.Get("name").(string))
+
+	interfaceType, err := netbox.NewInterfaceTypeValueFromValue(
+		d.Get("type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetType(*interfaceType)
+
+	if d.HasChange("bridge_id") {
+		if bridgeID := d.Get("bridge_id").(int); bridgeID != 0 {
+			bridgeID32, err := safecast.ToInt32(bridgeID)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetBridge(bridgeID32)
+		} else {
+			resource.SetBridgeNil()
+		}
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("duplex") {
+		if duplex := d.Get("duplex").(string); duplex != "" {
+			dp, err := netbox.NewInterfaceRequestDuplexFromValue(duplex)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetDuplex(*dp)
+		} else {
+			resource.SetDuplexNil()
+		}
+	}
+
+	if d.HasChange("enabled") {
+		resource.SetEnabled(d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("label") {
+		resource.SetLabel(d.Get("label").(string))
+	}
+
+	if d.HasChange("lag_id") {
+		if lagID := d.Get("lag_id").(int); lagID != 0 {
+			lagID32, err := safecast.ToInt32(lagID)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetLag(lagID32)
+		} else {
+			resource.SetLagNil()
+		}
+	}
+
+	if d.HasChange("mac_address") {
+		if macAddress := d.Get("mac_address").(string); macAddress != "" {
+			resource.SetMacAddress(macAddress)
+		} else {
+			resource.SetMacAddressNil()
+		}
+	}
+
+	if d.HasChange("mark_connected") {
+		resource.SetMarkConnected(d.Get("mark_connected").(bool))
+	}
+
+	if d.HasChange("mgmt_only") {
+		resource.SetMgmtOnly(d.Get("mgmt_only").(bool))
+	}
+
+	if d.HasChange("mode") {
+		mode, err := netbox.NewPatchedWritableInterfaceRequestModeFromValue(
+			d.Get("mode").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetMode(*mode)
+	}
+
+	if d.HasChange("mtu") {
+		if mtu := d.Get("mtu").(int); mtu != 0 {
+			mtu32, err := safecast.ToInt32(mtu)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetMtu(mtu32)
+		} else {
+			resource.SetMtuNil()
+		}
+	}
+
+	if d.HasChange("parent_id") {
+		if parentID := d.Get("parent_id").(int); parentID != 0 {
+			parentID32, err := safecast.ToInt32(parentID)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetParent(parentID32)
+		} else {
+			resource.SetParentNil()
+		}
+	}
+
+	if d.HasChange("poe_mode") {
+		poeMode, err := netbox.NewInterfacePoeModeValueFromValue(
+			d.Get("poe_mode").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetPoeMode(*poeMode)
+	}
+
+	if d.HasChange("poe_type") {
+		poeType, err := netbox.NewInterfacePoeTypeValueFromValue(
+			d.Get("poe_type").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetPoeType(*poeType)
+	}
+
+	if d.HasChange("rf_channel") {
+		c, err := netbox.NewWirelessChannelFromValue(
+			d.Get("rf_channel").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetRfChannel(*c)
+	}
+
+	if d.HasChange("rf_channel_frequency") {
+		if frequency, ok := d.GetOk("rf_channel_frequency"); ok {
+			resource.SetRfChannelFrequency(frequency.(float64))
+		} else {
+			resource.SetRfChannelFrequencyNil()
+		}
+	}
+
+	if d.HasChange("rf_channel_width") {
+		if width, ok := d.GetOk("rf_channel_width"); ok {
+			resource.SetRfChannelWidth(width.(float64))
+		} else {
+			resource.SetRfChannelWidthNil()
+		}
+	}
+
+	if d.HasChange("rf_role") {
+		r, err := netbox.NewWirelessRoleFromValue(d.Get("rf_role").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetRfRole(*r)
+	}
+
+	if d.HasChange("speed") {
+		if speed := d.Get("speed").(int); speed != 0 {
+			speed32, err := safecast.ToInt32(speed)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetSpeed(speed32)
+		} else {
+			resource.SetSpeedNil()
+		}
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tagged_vlans") {
+		taggedVlans := d.Get("tagged_vlans").(*schema.Set).List()
+		tvlans, err := util.ExpandToInt32Slice(taggedVlans)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetTaggedVlans(tvlans)
+	}
+
+	if d.HasChange("tx_power") {
+		if txPower, ok := d.GetOk("tx_power"); ok {
+			txPower32, err := safecast.ToInt32(txPower.(int))
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetTxPower(txPower32)
+		} else {
+			resource.SetTxPowerNil()
+		}
+	}
+
+	if d.HasChange("untagged_vlan") {
+		if untaggedVlan := d.Get("untagged_vlan").(int); untaggedVlan != 0 {
+			b, err := brief.GetBriefVLANRequestFromID(ctx, client, untaggedVlan)
+			if err != nil {
+				return err
+			}
+			resource.SetUntaggedVlan(*b)
+		} else {
+			resource.SetUntaggedVlanNil()
+		}
+	}
+
+	if d.HasChange("vrf_id") {
+		if vrfID := d.Get("vrf_id").(int); vrfID != 0 {
+			b, err := brief.GetBriefVRFRequestFromID(ctx, client, vrfID)
+			if err != nil {
+				return err
+			}
+			resource.SetVrf(*b)
+		} else {
+			resource.SetVrfNil()
+		}
+	}
+
+	if d.HasChange("wireless_lans") {
+		wirelessLans := d.Get("wireless_lans").(*schema.Set).List()
+		wlans, err := util.ExpandToInt32Slice(wirelessLans)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetWirelessLans(wlans)
+	}
+
+	if _, response, err := util.DecodeInterfaceResponse(
+		client.DcimAPI.DcimInterfacesUpdate(ctx,
+			int32(resourceID)).WritableInterfaceRequest(
+			*resource).Execute()); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimInterfaceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInterfaceDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimInterfaceExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimInterfacesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := util.DecodeInterfaceResponse(
+		client.DcimAPI.DcimInterfacesRetrieve(nil, int32(resourceID)).Execute())
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,194 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimInterface = "netbox_dcim_interface.test"
+
+func TestAccNetboxDcimInterfaceMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInterface,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInterfaceFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInterface,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInterfaceMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimInterfaceConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimintf-{{ .namesuffix }}"
+		slug = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_vlan" "test" {
+		vlan_id = 100
+		name    = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_vrf" "test" {
+		name = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_interface" "lag" {
+		device_id = netbox_dcim_device.test.id
+		name      = "lag-{{ .namesuffix }}"
+		type      = "lag"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimintf-{{ .namesuffix }}"
+		slug = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimintf-{{ .namesuffix }}"
+		slug            = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimintf-{{ .namesuffix }}"
+		slug = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimintf-{{ .namesuffix }}"
+		slug = "dcimintf-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimintf-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimintf-{{ .namesuffix }}"
+		type      = "1000base-t"
+
+		{{ if eq .resourcefull "true" }}
+		description    = "Test interface"
+		duplex         = "full"
+		enabled        = false
+		label          = "Port 1"
+		lag_id         = netbox_dcim_interface.lag.id
+		mac_address    = "AA:AA:AA:AA:AA:AA"
+		mark_connected = true
+		mgmt_only      = true
+		mode           = "tagged"
+		mtu            = 1500
+		poe_mode       = "pse"
+		poe_type       = "type2-ieee802.3at"
+		speed          = 1000000
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tagged_vlans  = [netbox_ipam_vlan.test.id]
+		untagged_vlan = netbox_ipam_vlan.test.id
+		vrf_id        = netbox_ipam_vrf.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,502 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+var inventoryItemComponentTypes = []string{
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+func ResourceNetboxDcimInventoryItem() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an inventory item of a device within Netbox.",
+		CreateContext: resourceNetboxDcimInventoryItemCreate,
+		ReadContext:   resourceNetboxDcimInventoryItemRead,
+		UpdateContext: resourceNetboxDcimInventoryItemUpdate,
+		DeleteContext: resourceNetboxDcimInventoryItemDelete,
+		Exists:        resourceNetboxDcimInventoryItemExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "A unique tag used to identify this inventory item.",
+			},
+			"component_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"component_type"},
+				Description: "ID of the component of the device assigned to " +
+					"this inventory item.",
+			},
+			"component_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"component_id"},
+				ValidateFunc: validation.StringInSlice(
+					inventoryItemComponentTypes, false),
+				Description: "The type of the component of the device " +
+					"assigned to this inventory item (dcim.interface, " +
+					"dcim.frontport, ...).",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this inventory item.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this inventory item was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this inventory item.",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the device of this inventory item.",
+			},
+			"discovered": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Was this inventory item automatically " +
+					"discovered (false by default)?",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const64),
+				Description:  "The physical label of this inventory item.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this inventory item was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the manufacturer of this inventory item.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this inventory item.",
+			},
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the parent inventory item of this " +
+					"inventory item.",
+			},
+			"part_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description: "The manufacturer-assigned part identifier of " +
+					"this inventory item.",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the role of this inventory item.",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "The serial number of this inventory item.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this inventory item.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimInventoryItemCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewInventoryItemRequest(*b,
+		d.Get("name").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetDiscovered(d.Get("discovered").(bool))
+	newResource.SetLabel(d.Get("label").(string))
+	newResource.SetPartId(d.Get("part_id").(string))
+	newResource.SetSerial(d.Get("serial").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if assetTag := d.Get("asset_tag").(string); assetTag != "" {
+		newResource.SetAssetTag(assetTag)
+	}
+
+	if componentType := d.Get("component_type").(string); componentType != "" {
+		newResource.SetComponentType(componentType)
+		newResource.SetComponentId(int64(d.Get("component_id").(int)))
+	}
+
+	if manufacturerID := d.Get("manufacturer_id").(int); manufacturerID != 0 {
+		b, errDiag := brief.GetBriefManufacturerRequestFromID(ctx, client,
+			manufacturerID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetManufacturer(*b)
+	}
+
+	if parentID := d.Get("parent_id").(int); parentID != 0 {
+		parentID32, err := safecast.ToInt32(parentID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetParent(parentID32)
+	}
+
+	if roleID := d.Get("role_id").(int); roleID != 0 {
+		b, errDiag := brief.GetBriefInventoryItemRoleRequestFromID(ctx,
+			client, roleID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetRole(*b)
+	}
+
+	_, response, err := client.DcimAPI.DcimInventoryItemsCreate(
+		ctx).InventoryItemRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimInventoryItemRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInventoryItemRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimInventoryItemsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("asset_tag", resource.GetAssetTag()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("component_id", resource.GetComponentId()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("component_type",
+		resource.GetComponentType()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_id", resource.GetDevice().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("discovered", resource.GetDiscovered()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("label", resource.GetLabel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("manufacturer_id",
+		resource.GetManufacturer().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("parent_id", resource.GetParent()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("part_id", resource.GetPartId()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("role_id", resource.GetRole().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("serial", resource.GetSerial()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimInventoryItemUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewInventoryItemRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetDevice(*b)
+	resource.SetName(d.Get("name").(string))
+
+	if d.HasChange("asset_tag") {
+		if assetTag := d.Get("asset_tag").(string); assetTag != "" {
+			resource.SetAssetTag(assetTag)
+		} else {
+			resource.SetAssetTagNil()
+		}
+	}
+
+	if d.HasChanges("component_id", "component_type") {
+		if componentType := d.Get("component_type").(string); componentType != "" {
+			resource.SetComponentType(componentType)
+			resource.SetComponentId(int64(d.Get("component_id").(int)))
+		} else {
+			resource.SetComponentTypeNil()
+			resource.SetComponentIdNil()
+		}
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("discovered") {
+		resource.SetDiscovered(d.Get("discovered").(bool))
+	}
+
+	if d.HasChange("label") {
+		resource.SetLabel(d.Get("label").(string))
+	}
+
+	if d.HasChange("manufacturer_id") {
+		if manufacturerID := d.Get("manufacturer_id").(int); manufacturerID != 0 {
+			b, errDiag := brief.GetBriefManufacturerRequestFromID(ctx, client,
+				manufacturerID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetManufacturer(*b)
+		} else {
+			resource.SetManufacturerNil()
+		}
+	}
+
+	if d.HasChange("parent_id") {
+		if parentID := d.Get("parent_id").(int); parentID != 0 {
+			parentID32, err := safecast.ToInt32(parentID)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetParent(parentID32)
+		} else {
+			resource.SetParentNil()
+		}
+	}
+
+	if d.HasChange("part_id") {
+		resource.SetPartId(d.Get("part_id").(string))
+	}
+
+	if d.HasChange("role_id") {
+		if roleID := d.Get("role_id").(int); roleID != 0 {
+			b, errDiag := brief.GetBriefInventoryItemRoleRequestFromID(ctx,
+				client, roleID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetRole(*b)
+		} else {
+			resource.SetRoleNil()
+		}
+	}
+
+	if d.HasChange("serial") {
+		resource.SetSerial(d.Get("serial").(string))
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.DcimAPI.DcimInventoryItemsUpdate(ctx,
+		int32(resourceID)).InventoryItemRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimInventoryItemRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInventoryItemDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimInventoryItemExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimInventoryItemsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimInventoryItemsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
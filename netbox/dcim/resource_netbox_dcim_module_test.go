@@ -0,0 +1,191 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimModule = "netbox_dcim_module.test"
+
+func TestAccNetboxDcimModuleMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimModule,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"adopt_components", "replicate_components"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimModule,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"adopt_components", "replicate_components"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimModuleConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimmodule-{{ .namesuffix }}"
+		slug = "dcimmodule-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimmodule-{{ .namesuffix }}"
+		slug            = "dcimmodule-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimmodule-{{ .namesuffix }}"
+		slug = "dcimmodule-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimmodule-{{ .namesuffix }}"
+		slug = "dcimmodule-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimmodule-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimmodule-{{ .namesuffix }}"
+		slug = "dcimmodule-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_module_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimmodule-{{ .namesuffix }}"
+
+		interface_template {
+			name = "eth0"
+			type = "1000base-t"
+		}
+	}
+
+	resource "netbox_dcim_module_bay" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimmodule-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module" "test" {
+		device_id      = netbox_dcim_device.test.id
+		module_bay_id  = netbox_dcim_module_bay.test.id
+		module_type_id = netbox_dcim_module_type.test.id
+
+		{{ if eq .resourcefull "true" }}
+		adopt_components = false
+		asset_tag = "dcimmodule-{{ .namesuffix }}"
+		comments = <<-EOT
+		Comments for Test Module
+		Multiline
+		EOT
+		description = "Test module"
+		replicate_components = true
+		serial = "dcimmodule-{{ .namesuffix }}"
+		status = "planned"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
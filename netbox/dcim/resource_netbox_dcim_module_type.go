@@ -0,0 +1,395 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+var moduleTypeTemplates = []string{
+	"console_port_template",
+	"front_port_template",
+	"interface_template",
+	"power_port_template",
+	"rear_port_template",
+}
+
+func ResourceNetboxDcimModuleType() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a module type within Netbox.",
+		CreateContext: resourceNetboxDcimModuleTypeCreate,
+		ReadContext:   resourceNetboxDcimModuleTypeRead,
+		UpdateContext: resourceNetboxDcimModuleTypeUpdate,
+		DeleteContext: resourceNetboxDcimModuleTypeDelete,
+		Exists:        resourceNetboxDcimModuleTypeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this module type.",
+			},
+			"console_port_template": consolePortTemplateSchema(),
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this module type.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module type was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this module type.",
+			},
+			"front_port_template": frontPortTemplateSchema(),
+			"interface_template":  interfaceTemplateSchema(),
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module type was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The manufacturer of this module type.",
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The model of this module type.",
+			},
+			"part_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const50),
+				Description:  "The part number of this module type.",
+			},
+			"power_port_template": powerPortTemplateSchema(),
+			"rear_port_template":  rearPortTemplateSchema(),
+			"tag":                 &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this module type.",
+			},
+			"weight": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The weight of this module type.",
+			},
+			"weight_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"weight"},
+				ValidateFunc: validation.StringInSlice([]string{"kg", "g",
+					"lb", "oz"}, false),
+				Description: "The unit among kg, g, lb or oz of the weight " +
+					"of this module type.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimModuleTypeCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	manufacturer, errDiag := brief.GetBriefManufacturerRequestFromID(ctx,
+		client, d.Get("manufacturer_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewWritableModuleTypeRequestWithDefaults()
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetManufacturer(*manufacturer)
+	newResource.SetModel(d.Get("model").(string))
+	newResource.SetPartNumber(d.Get("part_number").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if weight, ok := d.GetOk("weight"); ok {
+		newResource.SetWeight(weight.(float64))
+	}
+
+	if weightUnit := d.Get("weight_unit").(string); weightUnit != "" {
+		w, err := netbox.NewDeviceTypeWeightUnitValueFromValue(weightUnit)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetWeightUnit(*w)
+	}
+
+	_, response, err := client.DcimAPI.DcimModuleTypesCreate(
+		ctx).WritableModuleTypeRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+
+	parent, errDiag := newModuleTypeTemplateParent(ctx, client,
+		int(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if errDiag := reconcileComponentTemplates(ctx, client, d, parent,
+		moduleTypeTemplates); errDiag != nil {
+		return errDiag
+	}
+
+	return resourceNetboxDcimModuleTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleTypeRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimModuleTypesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("manufacturer_id",
+		resource.GetManufacturer().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("model", resource.GetModel()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("part_number", resource.GetPartNumber()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("weight", resource.GetWeight()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("weight_unit",
+		resource.GetWeightUnit().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	parent := &templateParent{id: resource.GetId(), module: true}
+	return readComponentTemplates(ctx, client, d, parent,
+		moduleTypeTemplates)
+}
+
+func resourceNetboxDcimModuleTypeUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableModuleTypeRequestWithDefaults()
+
+	// Required fields
+	manufacturer, errDiag := brief.GetBriefManufacturerRequestFromID(ctx,
+		client, d.Get("manufacturer_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetManufacturer(*manufacturer)
+	resource.SetModel(d.Get("model").(string))
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("part_number") {
+		resource.SetPartNumber(d.Get("part_number").(string))
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("weight") {
+		if weight, ok := d.GetOk("weight"); ok {
+			resource.SetWeight(weight.(float64))
+		} else {
+			resource.SetWeightNil()
+		}
+	}
+
+	if d.HasChange("weight_unit") {
+		w, err := netbox.NewDeviceTypeWeightUnitValueFromValue(
+			d.Get("weight_unit").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetWeightUnit(*w)
+	}
+
+	if _, response, err := client.DcimAPI.DcimModuleTypesUpdate(ctx,
+		int32(resourceID)).WritableModuleTypeRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	parent, errDiag := newModuleTypeTemplateParent(ctx, client,
+		int(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if errDiag := reconcileComponentTemplates(ctx, client, d, parent,
+		moduleTypeTemplates); errDiag != nil {
+		return errDiag
+	}
+
+	return resourceNetboxDcimModuleTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleTypeDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimModuleTypeExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimModuleTypesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleTypeExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimModuleTypesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,194 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimModuleType = "netbox_dcim_module_type.test"
+
+func TestAccNetboxDcimModuleTypeMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModuleType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimModuleType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleTypeFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleTypeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModuleType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimModuleType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleTypeMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModuleType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleTypeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModuleType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleTypeConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModuleType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleTypeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModuleType),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimModuleTypeConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimmoduletype-{{ .namesuffix }}"
+		slug = "dcimmoduletype-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimmoduletype-{{ .namesuffix }}"
+		slug = "dcimmoduletype-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_module_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimmoduletype-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Module Type
+		Multiline
+		EOT
+		description = "Test module type"
+		part_number = "dcimmoduletype-{{ .namesuffix }}"
+		weight = 10.5
+		weight_unit = "kg"
+
+		console_port_template {
+			name = "console"
+			type = "rj-45"
+		}
+
+		interface_template {
+			name = "eth0"
+			type = "1000base-t"
+			mgmt_only = true
+		}
+
+		interface_template {
+			name = "eth1"
+			type = "10gbase-x-sfpp"
+			description = "Uplink"
+			poe_mode = "pse"
+			poe_type = "type1-ieee802.3af"
+		}
+
+		power_port_template {
+			name = "psu0"
+			type = "iec-60320-c14"
+			maximum_draw = 500
+			allocated_draw = 250
+		}
+
+		rear_port_template {
+			name = "rear0"
+			type = "8p8c"
+			positions = 2
+		}
+
+		front_port_template {
+			name = "front0"
+			type = "8p8c"
+			rear_port = "rear0"
+			rear_port_position = 1
+		}
+
+		front_port_template {
+			name = "front1"
+			type = "8p8c"
+			rear_port = "rear0"
+			rear_port_position = 2
+		}
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
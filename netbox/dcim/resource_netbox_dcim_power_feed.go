@@ -0,0 +1,569 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+// Default values of Netbox for a power feed
+const (
+	powerFeedAmperageDefault       = 15
+	powerFeedMaxUtilizationDefault = 80
+	powerFeedVoltageDefault        = 120
+)
+
+func ResourceNetboxDcimPowerFeed() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a power feed within Netbox.",
+		CreateContext: resourceNetboxDcimPowerFeedCreate,
+		ReadContext:   resourceNetboxDcimPowerFeedRead,
+		UpdateContext: resourceNetboxDcimPowerFeedUpdate,
+		DeleteContext: resourceNetboxDcimPowerFeedDelete,
+		Exists:        resourceNetboxDcimPowerFeedExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"amperage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      powerFeedAmperageDefault,
+				ValidateFunc: validation.IntBetween(1, util.Const65535),
+				Description:  "The amperage of this power feed (15 by default).",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     nil,
+				Description: "Comments for this power feed.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this power feed.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this power feed was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this power feed.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this power feed was last updated.",
+			},
+			"mark_connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Treat this power feed as if a cable is " +
+					"connected.",
+			},
+			"max_utilization": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      powerFeedMaxUtilizationDefault,
+				ValidateFunc: validation.IntBetween(1, util.Const100),
+				Description: "The maximum permissible draw in percentage of " +
+					"this power feed (80 by default).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this power feed.",
+			},
+			"phase": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "single-phase",
+				ValidateFunc: validation.StringInSlice([]string{"single-phase",
+					"three-phase"}, false),
+				Description: "The phase among single-phase or three-phase of " +
+					"this power feed (single-phase by default).",
+			},
+			"power_panel_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the power panel of this power feed.",
+			},
+			"rack_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the rack fed by this power feed.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice([]string{"offline",
+					"active", "planned", "failed"}, false),
+				Description: "The status among offline, active, planned or " +
+					"failed of this power feed (active by default).",
+			},
+			"supply": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ac",
+				ValidateFunc: validation.StringInSlice([]string{"ac", "dc"},
+					false),
+				Description: "The supply among ac or dc of this power feed " +
+					"(ac by default).",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this power feed.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "primary",
+				ValidateFunc: validation.StringInSlice([]string{"primary",
+					"redundant"}, false),
+				Description: "The type among primary or redundant of this " +
+					"power feed (primary by default).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this power feed.",
+			},
+			"voltage": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  powerFeedVoltageDefault,
+				ValidateFunc: validation.All(
+					validation.IntBetween(-util.Const65535, util.Const65535),
+					validation.IntNotInSlice([]int{0})),
+				Description: "The voltage of this power feed " +
+					"(120 by default).",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimPowerFeedCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	b, errDiag := brief.GetBriefPowerPanelRequestFromID(ctx, client,
+		d.Get("power_panel_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewWritablePowerFeedRequest(*b,
+		d.Get("name").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetMarkConnected(d.Get("mark_connected").(bool))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	amperage, err := safecast.ToInt32(d.Get("amperage").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetAmperage(amperage)
+
+	maxUtilization, err := safecast.ToInt32(d.Get("max_utilization").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetMaxUtilization(maxUtilization)
+
+	phase, err := netbox.NewPatchedWritablePowerFeedRequestPhaseFromValue(
+		d.Get("phase").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetPhase(*phase)
+
+	if rackID := d.Get("rack_id").(int); rackID != 0 {
+		b, err := brief.GetBriefRackRequestFromID(ctx, client, rackID)
+		if err != nil {
+			return err
+		}
+		newResource.SetRack(*b)
+	}
+
+	status, err := netbox.NewPatchedWritablePowerFeedRequestStatusFromValue(
+		d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetStatus(*status)
+
+	supply, err := netbox.NewPatchedWritablePowerFeedRequestSupplyFromValue(
+		d.Get("supply").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetSupply(*supply)
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if err != nil {
+			return err
+		}
+		newResource.SetTenant(*b)
+	}
+
+	feedType, err := netbox.NewPatchedWritablePowerFeedRequestTypeFromValue(
+		d.Get("type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetType(*feedType)
+
+	voltage, err := safecast.ToInt32(d.Get("voltage").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetVoltage(voltage)
+
+	_, response, err := client.DcimAPI.DcimPowerFeedsCreate(
+		ctx).WritablePowerFeedRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimPowerFeedRead(ctx, d, m)
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimPowerFeedRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimPowerFeedsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("amperage", resource.GetAmperage()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mark_connected", resource.GetMarkConnected()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("max_utilization",
+		resource.GetMaxUtilization()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("phase", resource.GetPhase().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("power_panel_id", resource.GetPowerPanel().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rack_id", resource.GetRack().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("supply", resource.GetSupply().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("type", resource.GetType().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("voltage", resource.GetVoltage()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimPowerFeedUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritablePowerFeedRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefPowerPanelRequestFromID(ctx, client,
+		d.Get("power_panel_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetPowerPanel(*b)
+	resource.SetName(d.Get("name").(string))
+
+	if d.HasChange("amperage") {
+		amperage, err := safecast.ToInt32(d.Get("amperage").(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAmperage(amperage)
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("mark_connected") {
+		resource.SetMarkConnected(d.Get("mark_connected").(bool))
+	}
+
+	if d.HasChange("max_utilization") {
+		maxUtilization, err := safecast.ToInt32(
+			d.Get("max_utilization").(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetMaxUtilization(maxUtilization)
+	}
+
+	if d.HasChange("phase") {
+		phase, err := netbox.NewPatchedWritablePowerFeedRequestPhaseFromValue(
+			d.Get("phase").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetPhase(*phase)
+	}
+
+	if d.HasChange("rack_id") {
+		if rackID := d.Get("rack_id").(int); rackID != 0 {
+			b, err := brief.GetBriefRackRequestFromID(ctx, client, rackID)
+			if err != nil {
+				return err
+			}
+			resource.SetRack(*b)
+		} else {
+			resource.SetRackNil()
+		}
+	}
+
+	if d.HasChange("status") {
+		status, err := netbox.NewPatchedWritablePowerFeedRequestStatusFromValue(
+			d.Get("status").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetStatus(*status)
+	}
+
+	if d.HasChange("supply") {
+		supply, err := netbox.NewPatchedWritablePowerFeedRequestSupplyFromValue(
+			d.Get("supply").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetSupply(*supply)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+			if err != nil {
+				return err
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if d.HasChange("type") {
+		feedType, err := netbox.NewPatchedWritablePowerFeedRequestTypeFromValue(
+			d.Get("type").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetType(*feedType)
+	}
+
+	if d.HasChange("voltage") {
+		voltage, err := safecast.ToInt32(d.Get("voltage").(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetVoltage(voltage)
+	}
+
+	if _, response, err := client.DcimAPI.DcimPowerFeedsUpdate(ctx,
+		int32(resourceID)).WritablePowerFeedRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimPowerFeedRead(ctx, d, m)
+}
+
+func resourceNetboxDcimPowerFeedDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimPowerFeedExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimPowerFeedsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimPowerFeedExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimPowerFeedsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,171 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimPowerFeed = "netbox_dcim_power_feed.test"
+
+func TestAccNetboxDcimPowerFeedMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerFeedConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerFeed),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimPowerFeed,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimPowerFeedFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerFeedConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerFeed),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimPowerFeed,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimPowerFeedMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerFeedConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerFeed),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerFeedConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerFeed),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerFeedConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerFeed),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerFeedConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerFeed),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimPowerFeedConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimpowerfeed-{{ .namesuffix }}"
+		slug = "dcimpowerfeed-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "dcimpowerfeed-{{ .namesuffix }}"
+		slug = "dcimpowerfeed-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_rack" "test" {
+		name    = "dcimpowerfeed-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+		height  = 10
+		width   = 19
+	}
+	{{ end }}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimpowerfeed-{{ .namesuffix }}"
+		slug = "dcimpowerfeed-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_power_panel" "test" {
+		name    = "dcimpowerfeed-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_power_feed" "test" {
+		name           = "dcimpowerfeed-{{ .namesuffix }}"
+		power_panel_id = netbox_dcim_power_panel.test.id
+
+		{{ if eq .resourcefull "true" }}
+		amperage = 32
+		comments = <<-EOT
+		Comments for Test Power Feed
+		Multiline
+		EOT
+		description     = "Test power feed"
+		mark_connected  = true
+		max_utilization = 90
+		phase           = "three-phase"
+		rack_id         = netbox_dcim_rack.test.id
+		status          = "planned"
+		supply          = "ac"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		type      = "redundant"
+		voltage   = 400
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,173 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimPowerOutlet = "netbox_dcim_power_outlet.test"
+
+func TestAccNetboxDcimPowerOutletMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerOutletConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerOutlet),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimPowerOutlet,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimPowerOutletFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerOutletConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerOutlet),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimPowerOutlet,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimPowerOutletMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerOutletConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerOutlet),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerOutletConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerOutlet),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerOutletConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerOutlet),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerOutletConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerOutlet),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimPowerOutletConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimpoweroutlet-{{ .namesuffix }}"
+		slug = "dcimpoweroutlet-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_power_port" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimpoweroutlet-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimpoweroutlet-{{ .namesuffix }}"
+		slug = "dcimpoweroutlet-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimpoweroutlet-{{ .namesuffix }}"
+		slug            = "dcimpoweroutlet-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimpoweroutlet-{{ .namesuffix }}"
+		slug = "dcimpoweroutlet-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimpoweroutlet-{{ .namesuffix }}"
+		slug = "dcimpoweroutlet-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimpoweroutlet-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_power_outlet" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimpoweroutlet-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		description    = "Test power outlet"
+		feed_leg       = "A"
+		label          = "Outlet 1"
+		mark_connected = true
+		power_port_id  = netbox_dcim_power_port.test.id
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		type = "iec-60320-c13"
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,151 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimPowerPanel = "netbox_dcim_power_panel.test"
+
+func TestAccNetboxDcimPowerPanelMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerPanelConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerPanel),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimPowerPanel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimPowerPanelFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerPanelConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerPanel),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimPowerPanel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimPowerPanelMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimPowerPanelConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerPanel),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerPanelConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerPanel),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerPanelConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerPanel),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimPowerPanelConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimPowerPanel),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimPowerPanelConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimpowerpanel-{{ .namesuffix }}"
+		slug = "dcimpowerpanel-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_location" "test" {
+		name    = "dcimpowerpanel-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+		slug    = "dcimpowerpanel-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimpowerpanel-{{ .namesuffix }}"
+		slug = "dcimpowerpanel-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_power_panel" "test" {
+		name    = "dcimpowerpanel-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Power Panel
+		Multiline
+		EOT
+		description = "Test power panel"
+		location_id = netbox_dcim_location.test.id
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
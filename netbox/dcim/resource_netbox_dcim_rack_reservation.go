@@ -0,0 +1,415 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimRackReservation() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a reservation of units of a rack within Netbox.",
+		CreateContext: resourceNetboxDcimRackReservationCreate,
+		ReadContext:   resourceNetboxDcimRackReservationRead,
+		UpdateContext: resourceNetboxDcimRackReservationUpdate,
+		DeleteContext: resourceNetboxDcimRackReservationDelete,
+		Exists:        resourceNetboxDcimRackReservationExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this rack reservation.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this rack reservation.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this rack reservation was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const200),
+				Description:  "The description of this rack reservation.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this rack reservation was last " +
+					"updated.",
+			},
+			"rack_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the rack of this rack reservation.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this rack reservation.",
+			},
+			"units": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				Description: "The units of the rack reserved by this rack " +
+					"reservation.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this rack reservation.",
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the user owning this rack reservation.",
+			},
+		},
+	}
+}
+
+// checkRackReservationUnits checks that the units exist in the rack, from
+// its starting unit to its highest unit. It is done at apply time as the
+// rack may be created or resized in the same plan.
+func checkRackReservationUnits(ctx context.Context,
+	client *netbox.APIClient, rackID int, units []any) error {
+
+	rackID32, err := safecast.ToInt32(rackID)
+	if err != nil {
+		return err
+	}
+
+	rack, response, err := client.DcimAPI.DcimRacksRetrieve(ctx,
+		rackID32).Execute()
+	if response != nil && response.StatusCode == util.Const404 {
+		return fmt.Errorf("rack %d not found", rackID)
+	} else if err != nil {
+		return err
+	}
+
+	first := int(rack.GetStartingUnit())
+	last := first + int(rack.GetUHeight()) - 1
+	for _, unit := range units {
+		if u := unit.(int); u < first || u > last {
+			return fmt.Errorf("unit %d is out of the units of the rack %s "+
+				"(%d-%d)", u, rack.GetName(), first, last)
+		}
+	}
+
+	return nil
+}
+
+func getRackReservationUnits(units []any) ([]int32, error) {
+	units32 := []int32{}
+	for _, unit := range units {
+		unit32, err := safecast.ToInt32(unit.(int))
+		if err != nil {
+			return nil, err
+		}
+		units32 = append(units32, unit32)
+	}
+
+	sort.Slice(units32, func(i, j int) bool {
+		return units32[i] < units32[j]
+	})
+
+	return units32, nil
+}
+
+func resourceNetboxDcimRackReservationCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	rackID := d.Get("rack_id").(int)
+	resourceUnits := d.Get("units").(*schema.Set).List()
+	if err := checkRackReservationUnits(ctx, client, rackID,
+		resourceUnits); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	r, errDiag := brief.GetBriefRackRequestFromID(ctx, client, rackID)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	units, err := getRackReservationUnits(resourceUnits)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	u, errDiag := brief.GetBriefUserRequestFromID(ctx, client,
+		d.Get("user_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	newResource := netbox.NewRackReservationRequest(*r, units, *u,
+		d.Get("description").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetTenant(*b)
+	}
+
+	_, response, err := client.DcimAPI.DcimRackReservationsCreate(
+		ctx).RackReservationRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxDcimRackReservationRead(ctx, d, m)
+}
+
+func resourceNetboxDcimRackReservationRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimRackReservationsRetrieve(
+		ctx, int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("rack_id", resource.GetRack().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("units", resource.GetUnits()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("user_id", resource.GetUser().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimRackReservationUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewRackReservationRequestWithDefaults()
+
+	// Required fields
+	rackID := d.Get("rack_id").(int)
+	resourceUnits := d.Get("units").(*schema.Set).List()
+	if d.HasChanges("rack_id", "units") {
+		if err := checkRackReservationUnits(ctx, client, rackID,
+			resourceUnits); err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+	}
+
+	r, errDiag := brief.GetBriefRackRequestFromID(ctx, client, rackID)
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetRack(*r)
+
+	units, err := getRackReservationUnits(resourceUnits)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetUnits(units)
+
+	u, errDiag := brief.GetBriefUserRequestFromID(ctx, client,
+		d.Get("user_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetUser(*u)
+	resource.SetDescription(d.Get("description").(string))
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client,
+				tenantID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if _, response, err := client.DcimAPI.DcimRackReservationsUpdate(ctx,
+		int32(resourceID)).RackReservationRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxDcimRackReservationRead(ctx, d, m)
+}
+
+func resourceNetboxDcimRackReservationDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimRackReservationExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimRackReservationsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimRackReservationExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimRackReservationsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
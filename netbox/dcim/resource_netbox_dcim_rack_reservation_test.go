@@ -0,0 +1,159 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimRackReservation = "" +
+	"netbox_dcim_rack_reservation.test"
+
+func TestAccNetboxDcimRackReservationMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimRackReservationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimRackReservation),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimRackReservation,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimRackReservationFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimRackReservationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimRackReservation),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimRackReservation,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimRackReservationMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimRackReservationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimRackReservation),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimRackReservationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimRackReservation),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimRackReservationConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimRackReservation),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimRackReservationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimRackReservation),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimRackReservationConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "dcimrackreservation-{{ .namesuffix }}"
+		slug = "dcimrackreservation-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_rack" "test" {
+		name    = "dcimrackreservation-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+		height  = 10
+		width   = 19
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimrackreservation-{{ .namesuffix }}"
+		slug = "dcimrackreservation-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "dcimrackreservation-{{ .namesuffix }}"
+		slug = "dcimrackreservation-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_rack_reservation" "test" {
+		description = "dcimrackreservation-{{ .namesuffix }}"
+		rack_id     = netbox_dcim_rack.test.id
+		units       = [1, 2]
+		user_id     = 1
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Rack Reservation
+		Multiline
+		EOT
+		tenant_id = netbox_tenancy_tenant.test.id
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
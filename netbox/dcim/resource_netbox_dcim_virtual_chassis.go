@@ -0,0 +1,555 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimVirtualChassis() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage a virtual chassis and its members within " +
+			"Netbox.",
+		CreateContext: resourceNetboxDcimVirtualChassisCreate,
+		ReadContext:   resourceNetboxDcimVirtualChassisRead,
+		UpdateContext: resourceNetboxDcimVirtualChassisUpdate,
+		DeleteContext: resourceNetboxDcimVirtualChassisDelete,
+		Exists:        resourceNetboxDcimVirtualChassisExists,
+		CustomizeDiff: resourceNetboxDcimVirtualChassisCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this virtual chassis.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this virtual chassis.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this virtual chassis was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this virtual chassis.",
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const30),
+				Description:  "The domain of this virtual chassis.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this virtual chassis was last " +
+					"updated.",
+			},
+			"master_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the master device of this virtual " +
+					"chassis, the device must be a member.",
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "The member devices of this virtual chassis, " +
+					"the devices which are not listed are detached.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "ID of the member device.",
+						},
+						"vc_position": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ValidateFunc: validation.IntBetween(0,
+								util.Const255),
+							Description: "The position of the device in " +
+								"this virtual chassis.",
+						},
+						"vc_priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.IntBetween(0,
+								util.Const255),
+							Description: "The priority of the device to " +
+								"be elected as master.",
+						},
+					},
+				},
+			},
+			"member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The number of members of this virtual " +
+					"chassis.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this virtual chassis.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this virtual chassis.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimVirtualChassisCustomizeDiff(_ context.Context,
+	d *schema.ResourceDiff, _ any) error {
+
+	masterID := d.Get("master_id").(int)
+
+	// The master or the members are unknown until apply
+	if masterID == 0 || !d.NewValueKnown("master_id") ||
+		!d.NewValueKnown("member") {
+		return nil
+	}
+
+	for _, m := range d.Get("member").(*schema.Set).List() {
+		deviceID := m.(map[string]any)["device_id"].(int)
+		if deviceID == 0 || deviceID == masterID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the master (device %d) must be a member of the "+
+		"virtual chassis", masterID)
+}
+
+func resourceNetboxDcimVirtualChassisCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	// The master is set once the members are attached
+	newResource := netbox.NewWritableVirtualChassisRequest(
+		d.Get("name").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetDomain(d.Get("domain").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	_, response, err := client.DcimAPI.DcimVirtualChassisCreate(
+		ctx).WritableVirtualChassisRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+
+	priorities := configuredVirtualChassisPriorities(d)
+	for _, member := range d.Get("member").(*schema.Set).List() {
+		errDiag := attachVirtualChassisMember(ctx, client, int(resourceID),
+			member.(map[string]any), priorities)
+		if errDiag != nil {
+			return errDiag
+		}
+	}
+
+	if masterID := d.Get("master_id").(int); masterID != 0 {
+		masterID32, err := safecast.ToInt32(masterID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetMaster(masterID32)
+
+		if _, response, err := client.DcimAPI.DcimVirtualChassisUpdate(ctx,
+			resourceID).WritableVirtualChassisRequest(
+			*newResource).Execute(); err != nil {
+			return util.GenerateErrorMessage(response, err)
+		}
+	}
+
+	return resourceNetboxDcimVirtualChassisRead(ctx, d, m)
+}
+
+func resourceNetboxDcimVirtualChassisRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimVirtualChassisRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("domain", resource.GetDomain()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("master_id", resource.GetMaster().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	request := client.DcimAPI.DcimDevicesList(ctx).VirtualChassisId(
+		[]int32{int32(resourceID)}).Limit(util.Const1000)
+	devices, response, err := util.ListAll[netbox.DeviceWithConfigContext,
+		*netbox.PaginatedDeviceWithConfigContextList](request)
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	members := []any{}
+	for _, device := range devices {
+		members = append(members, map[string]any{
+			"device_id":   device.GetId(),
+			"vc_position": device.GetVcPosition(),
+			"vc_priority": device.GetVcPriority(),
+		})
+	}
+
+	if err = d.Set("member", members); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("member_count", resource.GetMemberCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimVirtualChassisUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableVirtualChassisRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+
+	// The new members are attached before the master is changed and the
+	// old ones are detached once they can no longer be the master
+	oldMembers, newMembers := d.GetChange("member")
+	if d.HasChange("member") {
+		priorities := configuredVirtualChassisPriorities(d)
+		for _, member := range newMembers.(*schema.Set).Difference(
+			oldMembers.(*schema.Set)).List() {
+
+			errDiag := attachVirtualChassisMember(ctx, client,
+				int(resourceID), member.(map[string]any), priorities)
+			if errDiag != nil {
+				return errDiag
+			}
+		}
+	}
+
+	if masterID := d.Get("master_id").(int); masterID != 0 {
+		masterID32, err := safecast.ToInt32(masterID)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetMaster(masterID32)
+	} else {
+		resource.SetMasterNil()
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("domain") {
+		resource.SetDomain(d.Get("domain").(string))
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.DcimAPI.DcimVirtualChassisUpdate(ctx,
+		int32(resourceID)).WritableVirtualChassisRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if d.HasChange("member") {
+		for _, deviceID := range removedVirtualChassisMembers(
+			oldMembers.(*schema.Set), newMembers.(*schema.Set)) {
+
+			errDiag := detachVirtualChassisMember(ctx, client, deviceID)
+			if errDiag != nil {
+				return errDiag
+			}
+		}
+	}
+
+	return resourceNetboxDcimVirtualChassisRead(ctx, d, m)
+}
+
+func resourceNetboxDcimVirtualChassisDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimVirtualChassisExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimVirtualChassisDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimVirtualChassisExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimVirtualChassisRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// configuredVirtualChassisPriorities returns the IDs of the member devices
+// with a priority in the configuration. An unset priority cannot be told
+// apart from a priority of 0 in the set of members, the raw configuration is
+// used instead.
+func configuredVirtualChassisPriorities(d *schema.ResourceData) map[int]bool {
+	priorities := map[int]bool{}
+
+	members := d.GetRawConfig().GetAttr("member")
+	if members.IsNull() || !members.IsKnown() {
+		return priorities
+	}
+
+	for it := members.ElementIterator(); it.Next(); {
+		_, member := it.Element()
+		deviceID := member.GetAttr("device_id")
+		if deviceID.IsNull() || !deviceID.IsKnown() ||
+			member.GetAttr("vc_priority").IsNull() {
+			continue
+		}
+
+		id, _ := deviceID.AsBigFloat().Int64()
+		priorities[int(id)] = true
+	}
+
+	return priorities
+}
+
+// attachVirtualChassisMember attaches a device to a virtual chassis or
+// updates its position and priority, the priority is only sent when it is
+// set in the configuration.
+func attachVirtualChassisMember(ctx context.Context,
+	client *netbox.APIClient, virtualChassisID int,
+	member map[string]any, priorities map[int]bool) diag.Diagnostics {
+
+	deviceID, err := safecast.ToInt32(member["device_id"].(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	vcPosition, err := safecast.ToInt32(member["vc_position"].(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	b, errDiag := brief.GetBriefVirtualChassisRequestFromID(ctx, client,
+		virtualChassisID)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	resource := netbox.
+		NewPatchedWritableDeviceWithConfigContextRequestWithDefaults()
+	resource.SetVirtualChassis(*b)
+	resource.SetVcPosition(vcPosition)
+
+	if priorities[member["device_id"].(int)] {
+		vcPriority32, err := safecast.ToInt32(member["vc_priority"].(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetVcPriority(vcPriority32)
+	} else {
+		resource.SetVcPriorityNil()
+	}
+
+	if _, response, err := client.DcimAPI.DcimDevicesPartialUpdate(ctx,
+		deviceID).PatchedWritableDeviceWithConfigContextRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+// detachVirtualChassisMember removes a device from its virtual chassis.
+func detachVirtualChassisMember(ctx context.Context,
+	client *netbox.APIClient, deviceID int32) diag.Diagnostics {
+
+	resource := netbox.
+		NewPatchedWritableDeviceWithConfigContextRequestWithDefaults()
+	resource.SetVirtualChassisNil()
+	resource.SetVcPositionNil()
+	resource.SetVcPriorityNil()
+
+	if _, response, err := client.DcimAPI.DcimDevicesPartialUpdate(ctx,
+		deviceID).PatchedWritableDeviceWithConfigContextRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+// removedVirtualChassisMembers returns the IDs of the devices which are no
+// longer members, a device whose position or priority changed is kept.
+func removedVirtualChassisMembers(oldMembers,
+	newMembers *schema.Set) []int32 {
+
+	kept := map[int]bool{}
+	for _, m := range newMembers.List() {
+		kept[m.(map[string]any)["device_id"].(int)] = true
+	}
+
+	var removed []int32
+	for _, m := range oldMembers.List() {
+		deviceID := m.(map[string]any)["device_id"].(int)
+		if !kept[deviceID] {
+			removed = append(removed, int32(deviceID))
+		}
+	}
+
+	return removed
+}
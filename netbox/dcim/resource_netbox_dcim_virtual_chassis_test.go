@@ -0,0 +1,183 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimVirtualChassis = "netbox_dcim_virtual_chassis.test"
+
+func TestAccNetboxDcimVirtualChassisMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualChassis),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimVirtualChassis,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimVirtualChassisFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualChassis),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimVirtualChassis,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimVirtualChassisMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualChassis),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualChassis),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualChassis),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualChassis),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimVirtualChassisConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimvirtualchassis-{{ .namesuffix }}"
+		slug = "dcimvirtualchassis-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimvirtualchassis-{{ .namesuffix }}"
+		slug            = "dcimvirtualchassis-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimvirtualchassis-{{ .namesuffix }}"
+		slug = "dcimvirtualchassis-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimvirtualchassis-{{ .namesuffix }}"
+		slug = "dcimvirtualchassis-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimvirtualchassis-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_device" "test2" {
+		name           = "dcimvirtualchassis2-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimvirtualchassis-{{ .namesuffix }}"
+		slug = "dcimvirtualchassis-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_virtual_chassis" "test" {
+		name = "dcimvirtualchassis-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Virtual Chassis
+		Multiline
+		EOT
+		description = "Test virtual chassis"
+		domain = "test"
+		master_id = netbox_dcim_device.test.id
+		member {
+			device_id = netbox_dcim_device.test.id
+			vc_position = 1
+			vc_priority = 255
+		}
+		member {
+			device_id = netbox_dcim_device.test2.id
+			vc_position = 2
+		}
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,591 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxDcimVirtualDeviceContext() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage a virtual device context of a device within " +
+			"Netbox.",
+		CreateContext: resourceNetboxDcimVirtualDeviceContextCreate,
+		ReadContext:   resourceNetboxDcimVirtualDeviceContextRead,
+		UpdateContext: resourceNetboxDcimVirtualDeviceContextUpdate,
+		DeleteContext: resourceNetboxDcimVirtualDeviceContextDelete,
+		Exists:        resourceNetboxDcimVirtualDeviceContextExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this virtual device context.",
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The content type of this virtual device " +
+					"context.",
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this virtual device context was " +
+					"created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this virtual device context.",
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the device of this virtual device " +
+					"context.",
+			},
+			"identifier": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The numeric identifier of this virtual device " +
+					"context, unique within its device.",
+			},
+			"interface_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The number of interfaces of this virtual " +
+					"device context.",
+			},
+			"interfaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "IDs of the interfaces of the device assigned " +
+					"to this virtual device context.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this virtual device context was " +
+					"last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const64),
+				Description:  "The name of this virtual device context.",
+			},
+			"primary_ip4_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the primary IPv4 of this virtual device " +
+					"context.",
+			},
+			"primary_ip6_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the primary IPv6 of this virtual device " +
+					"context.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedPatchedWritableVirtualDeviceContextRequestStatusEnumValues),
+					false),
+				Description: "The status among active, planned or offline " +
+					"(active by default) of this virtual device context.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the tenant of this virtual device " +
+					"context.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this virtual device context.",
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimVirtualDeviceContextCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	status, err := netbox.
+		NewPatchedWritableVirtualDeviceContextRequestStatusFromValue(
+			d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	// The primary IPs are set once the interfaces are assigned
+	newResource := netbox.NewWritableVirtualDeviceContextRequest(
+		d.Get("name").(string), *b, *status)
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if identifier := d.Get("identifier").(int); identifier != 0 {
+		identifier32, err := safecast.ToInt32(identifier)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetIdentifier(identifier32)
+	}
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if err != nil {
+			return err
+		}
+		newResource.SetTenant(*b)
+	}
+
+	_, response, err := client.DcimAPI.DcimVirtualDeviceContextsCreate(
+		ctx).WritableVirtualDeviceContextRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+
+	for _, id := range d.Get("interfaces").(*schema.Set).List() {
+		errDiag := assignInterfaceVirtualDeviceContext(ctx, client, id.(int),
+			resourceID, true)
+		if errDiag != nil {
+			return errDiag
+		}
+	}
+
+	primaryIP4ID := d.Get("primary_ip4_id").(int)
+	primaryIP6ID := d.Get("primary_ip6_id").(int)
+	if primaryIP4ID != 0 || primaryIP6ID != 0 {
+		if primaryIP4ID != 0 {
+			b, err := brief.GetBriefIPAdressRequestFromID(ctx, client,
+				primaryIP4ID)
+			if err != nil {
+				return err
+			}
+			newResource.SetPrimaryIp4(*b)
+		}
+
+		if primaryIP6ID != 0 {
+			b, err := brief.GetBriefIPAdressRequestFromID(ctx, client,
+				primaryIP6ID)
+			if err != nil {
+				return err
+			}
+			newResource.SetPrimaryIp6(*b)
+		}
+
+		if _, response, err := client.DcimAPI.DcimVirtualDeviceContextsUpdate(
+			ctx, resourceID).WritableVirtualDeviceContextRequest(
+			*newResource).Execute(); err != nil {
+			return util.GenerateErrorMessage(response, err)
+		}
+	}
+
+	return resourceNetboxDcimVirtualDeviceContextRead(ctx, d, m)
+}
+
+func resourceNetboxDcimVirtualDeviceContextRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.DcimAPI.DcimVirtualDeviceContextsRetrieve(
+		ctx, int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("device_id", resource.GetDevice().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("identifier", resource.GetIdentifier()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("interface_count",
+		resource.GetInterfaceCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	interfaces, errDiag := getVirtualDeviceContextInterfaceIDs(ctx, client,
+		int32(resourceID))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	if err = d.Set("interfaces", interfaces); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("primary_ip4_id",
+		resource.GetPrimaryIp4().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("primary_ip6_id",
+		resource.GetPrimaryIp6().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxDcimVirtualDeviceContextUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableVirtualDeviceContextRequestWithDefaults()
+
+	// Required fields
+	b, errDiag := brief.GetBriefDeviceRequestFromID(ctx, client,
+		d.Get("device_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetDevice(*b)
+	resource.SetName(d.Get("name").(string))
+
+	status, err := netbox.
+		NewPatchedWritableVirtualDeviceContextRequestStatusFromValue(
+			d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetStatus(*status)
+
+	// The new interfaces are assigned before the primary IPs are changed
+	// and the old ones are unassigned afterwards
+	oldInterfaces, newInterfaces := d.GetChange("interfaces")
+	if d.HasChange("interfaces") {
+		for _, id := range newInterfaces.(*schema.Set).Difference(
+			oldInterfaces.(*schema.Set)).List() {
+
+			errDiag := assignInterfaceVirtualDeviceContext(ctx, client,
+				id.(int), int32(resourceID), true)
+			if errDiag != nil {
+				return errDiag
+			}
+		}
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("identifier") {
+		if identifier := d.Get("identifier").(int); identifier != 0 {
+			identifier32, err := safecast.ToInt32(identifier)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetIdentifier(identifier32)
+		} else {
+			resource.SetIdentifierNil()
+		}
+	}
+
+	if d.HasChange("primary_ip4_id") {
+		if primaryIP4ID := d.Get("primary_ip4_id").(int); primaryIP4ID != 0 {
+			b, err := brief.GetBriefIPAdressRequestFromID(ctx, client,
+				primaryIP4ID)
+			if err != nil {
+				return err
+			}
+			resource.SetPrimaryIp4(*b)
+		} else {
+			resource.SetPrimaryIp4Nil()
+		}
+	}
+
+	if d.HasChange("primary_ip6_id") {
+		if primaryIP6ID := d.Get("primary_ip6_id").(int); primaryIP6ID != 0 {
+			b, err := brief.GetBriefIPAdressRequestFromID(ctx, client,
+				primaryIP6ID)
+			if err != nil {
+				return err
+			}
+			resource.SetPrimaryIp6(*b)
+		} else {
+			resource.SetPrimaryIp6Nil()
+		}
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+			if err != nil {
+				return err
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if _, response, err := client.DcimAPI.DcimVirtualDeviceContextsUpdate(ctx,
+		int32(resourceID)).WritableVirtualDeviceContextRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if d.HasChange("interfaces") {
+		for _, id := range oldInterfaces.(*schema.Set).Difference(
+			newInterfaces.(*schema.Set)).List() {
+
+			errDiag := assignInterfaceVirtualDeviceContext(ctx, client,
+				id.(int), int32(resourceID), false)
+			if errDiag != nil {
+				return errDiag
+			}
+		}
+	}
+
+	return resourceNetboxDcimVirtualDeviceContextRead(ctx, d, m)
+}
+
+func resourceNetboxDcimVirtualDeviceContextDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxDcimVirtualDeviceContextExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.DcimAPI.DcimVirtualDeviceContextsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimVirtualDeviceContextExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.DcimAPI.DcimVirtualDeviceContextsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// assignInterfaceVirtualDeviceContext adds or removes a virtual device
+// context from the ones of an interface.
+func assignInterfaceVirtualDeviceContext(ctx context.Context,
+	client *netbox.APIClient, id int, vdcID int32,
+	assign bool) diag.Diagnostics {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	request := client.DcimAPI.DcimVirtualDeviceContextsList(
+		ctx).InterfaceId([]int32{id32}).Limit(util.Const1000)
+	current, response, err := util.ListAll[netbox.VirtualDeviceContext,
+		*netbox.PaginatedVirtualDeviceContextList](request)
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	vdcs := []int32{}
+	for _, vdc := range current {
+		if vdc.GetId() != vdcID {
+			vdcs = append(vdcs, vdc.GetId())
+		}
+	}
+
+	if assign {
+		vdcs = append(vdcs, vdcID)
+	}
+
+	resource := netbox.NewPatchedWritableInterfaceRequestWithDefaults()
+	resource.SetVdcs(vdcs)
+
+	if _, response, err := util.DecodeInterfaceResponse(
+		client.DcimAPI.DcimInterfacesPartialUpdate(ctx,
+			id32).PatchedWritableInterfaceRequest(
+			*resource).Execute()); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func getVirtualDeviceContextInterfaceIDs(ctx context.Context,
+	client *netbox.APIClient, vdcID int32) ([]int32, diag.Diagnostics) {
+
+	request := client.DcimAPI.DcimInterfacesList(ctx).VdcId(
+		[]int32{vdcID}).Limit(util.Const1000)
+
+	interfaces, response, err := util.ListAll[netbox.Interface,
+		*netbox.PaginatedInterfaceList](interfacesListRequest{request})
+	if err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	ids := []int32{}
+	for _, iface := range interfaces {
+		ids = append(ids, iface.GetId())
+	}
+
+	return ids, nil
+}
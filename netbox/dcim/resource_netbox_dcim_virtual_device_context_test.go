@@ -0,0 +1,205 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimVirtualDeviceContext = "" +
+	"netbox_dcim_virtual_device_context.test"
+
+func TestAccNetboxDcimVirtualDeviceContextMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualDeviceContext),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimVirtualDeviceContext,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimVirtualDeviceContextFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualDeviceContext),
+				),
+			},
+			// The interface assigned to the virtual device context must still
+			// be readable
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists("netbox_dcim_interface.test"),
+					resource.TestCheckResourceAttr(
+						resourceNameNetboxDcimVirtualDeviceContext,
+						"interfaces.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimVirtualDeviceContext,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimVirtualDeviceContextMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualDeviceContext),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualDeviceContext),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualDeviceContext),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimVirtualDeviceContext),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimVirtualDeviceContextConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "dcimvdc-{{ .namesuffix }}"
+		slug = "dcimvdc-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "dcimvdc-{{ .namesuffix }}"
+		slug            = "dcimvdc-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "dcimvdc-{{ .namesuffix }}"
+		slug = "dcimvdc-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "dcimvdc-{{ .namesuffix }}"
+		slug = "dcimvdc-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "dcimvdc-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth0"
+		type      = "1000base-t"
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "dcimvdc-{{ .namesuffix }}"
+		slug = "dcimvdc-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "dcimvdc-{{ .namesuffix }}"
+		slug = "dcimvdc-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_ip_addresses" "test" {
+		address     = "192.168.56.1/24"
+		object_id   = netbox_dcim_interface.test.id
+		object_type = "dcim.interface"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_virtual_device_context" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "dcimvdc-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test Virtual Device Context
+		Multiline
+		EOT
+		description = "Test virtual device context"
+		identifier = 1
+		interfaces = [netbox_dcim_interface.test.id]
+		primary_ip4_id = netbox_ipam_ip_addresses.test.id
+		status = "planned"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
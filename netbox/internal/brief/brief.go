@@ -50,10 +50,19 @@ func GetBriefDeviceRequestFromID(ctx context.Context,
 		return nil, util.GenerateErrorMessage(response, err)
 	}
 
+	return newBriefDeviceRequest(resource.GetId(), resource.GetName()), nil
+}
+
+// The name of a device is optional and not unique, the ID is sent as well to
+// select the right one
+func newBriefDeviceRequest(id int32, name string) *netbox.BriefDeviceRequest {
 	m := netbox.NewBriefDeviceRequest()
-	m.SetName(resource.GetName())
+	if name != "" {
+		m.SetName(name)
+	}
+	m.AdditionalProperties = map[string]any{"id": id}
 
-	return m, nil
+	return m
 }
 
 func GetBriefDeviceRoleRequestFromID(ctx context.Context,
@@ -78,6 +87,180 @@ func GetBriefDeviceRoleRequestFromID(ctx context.Context,
 	return m, nil
 }
 
+func GetBriefDeviceTypeRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefDeviceTypeRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimDeviceTypesRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	manufacturer := resource.GetManufacturer()
+	m := netbox.NewBriefDeviceTypeRequest(
+		*netbox.NewBriefManufacturerRequest(manufacturer.GetName(),
+			manufacturer.GetSlug()), resource.GetModel(), resource.GetSlug())
+
+	return m, nil
+}
+
+func GetBriefModuleTypeRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefModuleTypeRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimModuleTypesRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	manufacturer := resource.GetManufacturer()
+	m := netbox.NewBriefModuleTypeRequest(
+		*netbox.NewBriefManufacturerRequest(manufacturer.GetName(),
+			manufacturer.GetSlug()), resource.GetModel())
+
+	return m, nil
+}
+
+func GetBriefModuleRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefModuleRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimModulesRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	device := newBriefDeviceRequest(resource.Device.GetId(),
+		resource.Device.GetName())
+	moduleBay := netbox.NewNestedModuleBayRequest(resource.ModuleBay.GetName())
+
+	// A module has no name, the ID is sent as well to select the right one
+	m := netbox.NewBriefModuleRequest(*device, *moduleBay)
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefRearPortTemplateRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefRearPortTemplateRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimRearPortTemplatesRetrieve(
+		ctx, id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The name of a rear port template is only unique within its device or
+	// module type, the ID is sent as well to select the right one
+	m := netbox.NewBriefRearPortTemplateRequest(resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefPowerPanelRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefPowerPanelRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimPowerPanelsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The name of a power panel is only unique within its site, the ID is
+	// sent as well to select the right one
+	m := netbox.NewBriefPowerPanelRequest(resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefPowerPortRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefPowerPortRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimPowerPortsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	device := newBriefDeviceRequest(resource.Device.GetId(),
+		resource.Device.GetName())
+
+	// The name of a power port is only unique within its device, the ID is
+	// sent as well to select the right one
+	m := netbox.NewBriefPowerPortRequest(*device, resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefVirtualChassisRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefVirtualChassisRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimVirtualChassisRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The name of a virtual chassis is not unique, the ID is sent as well to
+	// select the right one
+	m := netbox.NewBriefVirtualChassisRequest(resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
 func GetBriefPlatformRequestFromID(ctx context.Context,
 	client *netbox.APIClient, id int) (
 	*netbox.BriefPlatformRequest, diag.Diagnostics) {
@@ -374,6 +557,30 @@ func GetBriefLocationRequestFromID(ctx context.Context,
 	return m, nil
 }
 
+func GetBriefRackRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefRackRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.DcimAPI.DcimRacksRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The name of a rack is only unique within its location, the ID is sent
+	// as well to select the right one
+	m := netbox.NewBriefRackRequest(resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
 func GetBriefRackRoleRequestFromID(ctx context.Context,
 	client *netbox.APIClient, id int) (
 	*netbox.BriefRackRoleRequest, diag.Diagnostics) {
@@ -395,6 +602,28 @@ func GetBriefRackRoleRequestFromID(ctx context.Context,
 	return m, nil
 }
 
+func GetBriefInventoryItemRoleRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefInventoryItemRoleRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err :=
+		client.DcimAPI.DcimInventoryItemRolesRetrieve(ctx, id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefInventoryItemRoleRequest(resource.GetName(),
+		resource.GetSlug())
+
+	return m, nil
+}
+
 func GetBriefContactGroupRequestFromID(ctx context.Context,
 	client *netbox.APIClient, id int) (
 	*netbox.BriefContactGroupRequest, diag.Diagnostics) {
@@ -549,3 +778,343 @@ func GetBriefVirtualMachineRequestFromID(ctx context.Context,
 
 	return m, nil
 }
+
+func GetBriefUserRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefUserRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.UsersAPI.UsersUsersRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefUserRequest(resource.GetUsername())
+
+	return m, nil
+}
+
+func GetBriefProviderRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefProviderRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.CircuitsAPI.CircuitsProvidersRetrieve(
+		ctx, id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefProviderRequest(resource.GetName(), resource.GetSlug())
+
+	return m, nil
+}
+
+func GetBriefProviderAccountRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefProviderAccountRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err :=
+		client.CircuitsAPI.CircuitsProviderAccountsRetrieve(ctx,
+			id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The account is only unique for a provider, the ID is sent as well to
+	// select the right one
+	m := netbox.NewBriefProviderAccountRequest(resource.GetAccount())
+	m.SetName(resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefProviderNetworkRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefProviderNetworkRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err :=
+		client.CircuitsAPI.CircuitsProviderNetworksRetrieve(ctx,
+			id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The name of a provider network is only unique for a provider, the ID
+	// is sent as well to select the right one
+	m := netbox.NewBriefProviderNetworkRequest(resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefCircuitTypeRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefCircuitTypeRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.CircuitsAPI.CircuitsCircuitTypesRetrieve(
+		ctx, id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefCircuitTypeRequest(resource.GetName(),
+		resource.GetSlug())
+
+	return m, nil
+}
+
+func GetBriefCircuitRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefCircuitRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.CircuitsAPI.CircuitsCircuitsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The circuit ID is only unique for a provider, the ID is sent as well
+	// to select the right one
+	m := netbox.NewBriefCircuitRequest(resource.GetCid())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefTunnelGroupRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefTunnelGroupRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.VpnAPI.VpnTunnelGroupsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefTunnelGroupRequest(resource.GetName(),
+		resource.GetSlug())
+
+	return m, nil
+}
+
+func GetBriefTunnelRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefTunnelRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.VpnAPI.VpnTunnelsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefTunnelRequest(resource.GetName())
+
+	return m, nil
+}
+
+func GetBriefIPSecProfileRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefIPSecProfileRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.VpnAPI.VpnIpsecProfilesRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefIPSecProfileRequest(resource.GetName())
+
+	return m, nil
+}
+
+func GetBriefIKEPolicyRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefIKEPolicyRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.VpnAPI.VpnIkePoliciesRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefIKEPolicyRequest(resource.GetName())
+
+	return m, nil
+}
+
+func GetBriefIPSecPolicyRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefIPSecPolicyRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.VpnAPI.VpnIpsecPoliciesRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefIPSecPolicyRequest(resource.GetName())
+
+	return m, nil
+}
+
+func GetBriefL2VPNRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefL2VPNRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.VpnAPI.VpnL2vpnsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefL2VPNRequest(resource.GetName(), resource.GetSlug())
+
+	return m, nil
+}
+
+func GetBriefInterfaceRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefInterfaceRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := util.DecodeInterfaceResponse(
+		client.DcimAPI.DcimInterfacesRetrieve(ctx, id32).Execute())
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	device := newBriefDeviceRequest(resource.Device.GetId(),
+		resource.Device.GetName())
+
+	// The interface name is only unique for a device, the ID is sent as well
+	// to select the right one
+	m := netbox.NewBriefInterfaceRequest(*device, resource.GetName())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
+
+func GetBriefWirelessLANGroupRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefWirelessLANGroupRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.WirelessAPI.
+		WirelessWirelessLanGroupsRetrieve(ctx, id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	m := netbox.NewBriefWirelessLANGroupRequest(resource.GetName(),
+		resource.GetSlug())
+
+	return m, nil
+}
+
+func GetBriefFHRPGroupRequestFromID(ctx context.Context,
+	client *netbox.APIClient, id int) (
+	*netbox.BriefFHRPGroupRequest, diag.Diagnostics) {
+
+	id32, err := safecast.ToInt32(id)
+	if err != nil {
+		return nil, util.GenerateErrorMessage(nil, err)
+	}
+
+	resource, response, err := client.IpamAPI.IpamFhrpGroupsRetrieve(ctx,
+		id32).Execute()
+
+	if response.StatusCode == util.Const404 || err != nil {
+		return nil, util.GenerateErrorMessage(response, err)
+	}
+
+	// The protocol and group ID are not unique, the ID is sent as well to
+	// select the right FHRP group
+	m := netbox.NewBriefFHRPGroupRequest(resource.GetProtocol(),
+		resource.GetGroupId())
+	m.AdditionalProperties = map[string]any{"id": resource.GetId()}
+
+	return m, nil
+}
@@ -4,6 +4,7 @@
 package util
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,14 +27,17 @@ const Const10 = 10
 const Const19 = 19
 const Const21 = 21
 const Const23 = 23
+const Const30 = 30
 const Const32 = 32
 const Const50 = 50
 const Const64 = 64
 const Const100 = 100
+const Const127 = 127
 const Const128 = 128
 const Const200 = 200
 const Const201 = 201
 const Const254 = 254
+const Const255 = 255
 const Const256 = 256
 const Const404 = 404
 const Const1000 = 1000
@@ -91,6 +95,24 @@ func ConvertURLContentType(url string) string {
 	return contentType
 }
 
+func EnumToListofStrings[T ~string](in []T) []string {
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if v != "" {
+			out = append(out, string(v))
+		}
+	}
+	return out
+}
+
+func EnumToListofInts[T ~int32](in []T) []int {
+	out := make([]int, 0, len(in))
+	for _, v := range in {
+		out = append(out, int(v))
+	}
+	return out
+}
+
 func ExpandToInt64Slice(v []any) ([]int64, error) {
 	s := make([]int64, len(v))
 	for i, val := range v {
@@ -262,3 +284,99 @@ func UnmarshalID(body io.ReadCloser) (int32, error) {
 
 	return gr.ID, nil
 }
+
+// PaginatedList is implemented by the paginated lists returned by Netbox.
+type PaginatedList[T any] interface {
+	GetResults() []T
+	GetNext() string
+}
+
+// ListRequest is implemented by the list requests of the Netbox API.
+type ListRequest[R any, L any] interface {
+	Offset(offset int32) R
+	Execute() (L, *http.Response, error)
+}
+
+// ListAll executes the list request page by page until the last one and
+// returns the results of all the pages.
+func ListAll[T any, L PaginatedList[T], R ListRequest[R, L]](
+	request R) ([]T, *http.Response, error) {
+
+	results := []T{}
+	for {
+		offset, err := safecast.ToInt32(len(results))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		page, response, err := request.Offset(offset).Execute()
+		if err != nil {
+			return nil, response, err
+		}
+
+		pageResults := page.GetResults()
+		results = append(results, pageResults...)
+
+		if page.GetNext() == "" || len(pageResults) == 0 {
+			return results, response, nil
+		}
+	}
+}
+
+// DecodeInterfaceResponse decodes again the response of a request returning
+// interfaces when the generated client fails to decode it. The virtual device
+// contexts nested in the interfaces only have their brief fields whereas the
+// generated client expects complete ones, they are dropped as the provider
+// does not read them from the interfaces.
+func DecodeInterfaceResponse[T any](resource T, response *http.Response,
+	err error) (T, *http.Response, error) {
+
+	if err == nil || response == nil ||
+		(response.StatusCode != Const200 &&
+			response.StatusCode != Const201) {
+		return resource, response, err
+	}
+
+	body, readErr := io.ReadAll(response.Body)
+	if readErr != nil {
+		return resource, response, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	var raw any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&raw) != nil {
+		return resource, response, err
+	}
+	dropInterfaceVdcs(raw)
+
+	body, marshalErr := json.Marshal(raw)
+	if marshalErr != nil {
+		return resource, response, err
+	}
+
+	var decoded T
+	if decodeErr := json.Unmarshal(body, &decoded); decodeErr != nil {
+		return resource, response, decodeErr
+	}
+
+	return decoded, response, nil
+}
+
+// dropInterfaceVdcs removes the virtual device contexts of an interface, of a
+// list of interfaces or of a page of interfaces.
+func dropInterfaceVdcs(raw any) {
+	switch v := raw.(type) {
+	case []any:
+		for _, elem := range v {
+			dropInterfaceVdcs(elem)
+		}
+	case map[string]any:
+		if results, ok := v["results"]; ok {
+			dropInterfaceVdcs(results)
+		} else {
+			delete(v, "vdcs")
+		}
+	}
+}
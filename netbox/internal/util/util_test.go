@@ -0,0 +1,99 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package util
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testInterface struct {
+	ID   int   `json:"id"`
+	Vdcs []any `json:"vdcs"`
+}
+
+type testInterfaceList struct {
+	Count   int             `json:"count"`
+	Results []testInterface `json:"results"`
+}
+
+func testInterfaceResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestDecodeInterfaceResponse(t *testing.T) {
+	decodeErr := errors.New("no value given for required property device")
+
+	cases := map[string]struct {
+		body string
+		want any
+	}{
+		"interface": {
+			body: `{"id": 1, "vdcs": [{"id": 2, "name": "vdc"}]}`,
+			want: &testInterface{ID: 1},
+		},
+		"page": {
+			body: `{"count": 2, "results": [
+				{"id": 1, "vdcs": [{"id": 2}]}, {"id": 3, "vdcs": []}]}`,
+			want: &testInterfaceList{Count: 2,
+				Results: []testInterface{{ID: 1}, {ID: 3}}},
+		},
+		"bulk": {
+			body: `[{"id": 1, "vdcs": [{"id": 2}]}]`,
+			want: []testInterface{{ID: 1}},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			response := testInterfaceResponse(Const200, c.body)
+
+			var got any
+			var err error
+			switch c.want.(type) {
+			case *testInterface:
+				got, _, err = DecodeInterfaceResponse[*testInterface](nil,
+					response, decodeErr)
+			case *testInterfaceList:
+				got, _, err = DecodeInterfaceResponse[*testInterfaceList](nil,
+					response, decodeErr)
+			default:
+				got, _, err = DecodeInterfaceResponse[[]testInterface](nil,
+					response, decodeErr)
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %#v, want %#v", got, c.want)
+			}
+
+			// The body is still readable by the caller
+			body, _ := io.ReadAll(response.Body)
+			if string(body) != c.body {
+				t.Errorf("body = %q, want %q", body, c.body)
+			}
+		})
+	}
+}
+
+func TestDecodeInterfaceResponseError(t *testing.T) {
+	apiErr := errors.New("404 Not Found")
+	response := testInterfaceResponse(Const404, `{"detail": "Not found."}`)
+
+	_, _, err := DecodeInterfaceResponse[*testInterface](nil, response,
+		apiErr)
+	if !errors.Is(err, apiErr) {
+		t.Errorf("got %v, want %v", err, apiErr)
+	}
+}
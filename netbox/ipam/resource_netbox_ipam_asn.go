@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/ccoveille/go-safecast"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,9 +35,20 @@ func ResourceNetboxIpamASN() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"asn": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntAtLeast(1),
-				Description:  "The ASN number of this ASN.",
+				ExactlyOneOf: []string{"asn", "asn_range_id"},
+				RequiredWith: []string{"rir_id"},
+				Description: "The ASN number of this ASN. Required if " +
+					"asn_range_id is not set, rir_id is then required too.",
+			},
+			"asn_range_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Description: "ID of the ASN range used to allocate the next " +
+					"available ASN number. Required if asn is not set.",
 			},
 			"content_type": {
 				Type:        schema.TypeString,
@@ -67,9 +79,11 @@ func ResourceNetboxIpamASN() *schema.Resource {
 				Description: "The number of providers for this ASN.",
 			},
 			"rir_id": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The RIR for this ASN.",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "The RIR for this ASN. Required if asn_range_id " +
+					"is not set, the RIR of the ASN range is used otherwise.",
 			},
 			"site_count": {
 				Type:        schema.TypeInt,
@@ -100,22 +114,13 @@ func resourceNetboxIpamASNCreate(ctx context.Context,
 	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
 		nil, resourceCustomFields)
 
-	asn := int64(d.Get("asn").(int))
-	rirID := d.Get("rir_id").(int)
 	tags := d.Get("tag").(*schema.Set).List()
 
 	newResource := netbox.NewASNRequestWithDefaults()
-	newResource.SetAsn(asn)
 	newResource.SetCustomFields(customFields)
 	newResource.SetDescription(d.Get("description").(string))
 	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
 
-	b, err := brief.GetBriefRIRRequestFromID(ctx, client, rirID)
-	if err != nil {
-		return err
-	}
-	newResource.SetRir(*b)
-
 	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
 		b, err := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
 		if err != nil {
@@ -124,6 +129,32 @@ func resourceNetboxIpamASNCreate(ctx context.Context,
 		newResource.SetTenant(*b)
 	}
 
+	// The ASN number and the RIR are set by Netbox from the ASN range
+	if asnRangeID, ok := d.GetOk("asn_range_id"); ok {
+		asnRangeID32, err := safecast.ToInt32(asnRangeID.(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+
+		resourceID, errDiag := createNewAvailableASN(ctx, client,
+			asnRangeID32, *newResource)
+		if errDiag != nil {
+			return errDiag
+		}
+
+		d.SetId(fmt.Sprintf("%d", resourceID))
+		return resourceNetboxIpamASNRead(ctx, d, m)
+	}
+
+	rirID := d.Get("rir_id").(int)
+	newResource.SetAsn(int64(d.Get("asn").(int)))
+
+	b, err := brief.GetBriefRIRRequestFromID(ctx, client, rirID)
+	if err != nil {
+		return err
+	}
+	newResource.SetRir(*b)
+
 	_, response, errDiag := client.IpamAPI.IpamAsnsCreate(
 		ctx).ASNRequest(*newResource).Execute()
 	if response.StatusCode != util.Const201 && errDiag != nil {
@@ -0,0 +1,148 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxIpamAsnRange = "netbox_ipam_asn_range.test"
+
+func TestAccNetboxIpamAsnRangeMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamAsnRangeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsnRange),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxIpamAsnRange,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamAsnRangeFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamAsnRangeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsnRange),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxIpamAsnRange,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamAsnRangeMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamAsnRangeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsnRange),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamAsnRangeConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsnRange),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamAsnRangeConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsnRange),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamAsnRangeConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsnRange),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamAsnRangeConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_ipam_rir" "test" {
+		name = "ipamasnrange-{{ .namesuffix }}"
+		slug = "ipamasnrange-{{ .namesuffix }}"
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "ipamasnrange-{{ .namesuffix }}"
+		slug = "ipamasnrange-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "ipamasnrange-{{ .namesuffix }}"
+		slug = "ipamasnrange-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_ipam_asn_range" "test" {
+		name   = "ipamasnrange-{{ .namesuffix }}"
+		slug   = "ipamasnrange-{{ .namesuffix }}"
+		rir_id = netbox_ipam_rir.test.id
+		start  = 64512
+		end    = 64612
+
+		{{ if eq .resourcefull "true" }}
+		description = "Test ASN range"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
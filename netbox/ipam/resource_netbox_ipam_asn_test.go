@@ -111,6 +111,37 @@ func TestAccNetboxIpamAsnMinimalFullMinimal(t *testing.T) {
 	})
 }
 
+func TestAccNetboxIpamAsnFromRange(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+	start := int64(acctest.RandIntRange(1,
+		util.Const4294967295-util.Const100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamAsnFromRangeConfig(
+					nameSuffix, start),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(
+						resourceNameNetboxIpamAsn),
+					resource.TestCheckResourceAttr(
+						resourceNameNetboxIpamAsn, "asn",
+						strconv.FormatInt(start, util.Const10)),
+				),
+			},
+			{
+				ResourceName:            resourceNameNetboxIpamAsn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"asn_range_id"},
+			},
+		},
+	})
+}
+
 func testAccCheckNetboxIpamAsnConfig(nameSuffix string,
 	resourceFull, extraResources bool, asn int64) string {
 
@@ -157,3 +188,33 @@ func testAccCheckNetboxIpamAsnConfig(nameSuffix string,
 	}
 	return util.RenderTemplate(template, data)
 }
+
+func testAccCheckNetboxIpamAsnFromRangeConfig(nameSuffix string,
+	start int64) string {
+
+	template := `
+	resource "netbox_ipam_rir" "test" {
+		name = "ipamasn-{{ .namesuffix }}"
+		slug = "ipamasn-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_asn_range" "test" {
+		name   = "ipamasn-{{ .namesuffix }}"
+		slug   = "ipamasn-{{ .namesuffix }}"
+		rir_id = netbox_ipam_rir.test.id
+		start  = {{ .start }}
+		end    = {{ .end }}
+	}
+
+	resource "netbox_ipam_asn" "test" {
+		asn_range_id = netbox_ipam_asn_range.test.id
+		description  = "Test ASN"
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+		"start":      strconv.FormatInt(start, util.Const10),
+		"end":        strconv.FormatInt(start+util.Const100, util.Const10),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,262 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxIpamFHRPGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a FHRP group assignment within Netbox.",
+		CreateContext: resourceNetboxIpamFHRPGroupAssignmentCreate,
+		ReadContext:   resourceNetboxIpamFHRPGroupAssignmentRead,
+		UpdateContext: resourceNetboxIpamFHRPGroupAssignmentUpdate,
+		DeleteContext: resourceNetboxIpamFHRPGroupAssignmentDelete,
+		Exists:        resourceNetboxIpamFHRPGroupAssignmentExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The content type of this FHRP group " +
+					"assignment.",
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this FHRP group assignment was " +
+					"created.",
+			},
+			"fhrp_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the FHRP group of this FHRP group " +
+					"assignment.",
+			},
+			"interface_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the interface assigned to this FHRP " +
+					"group assignment.",
+			},
+			"interface_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					deviceInterfaceType, vMInterfaceType}, false),
+				Description: "The type of the interface assigned to this " +
+					"FHRP group assignment (dcim.interface or " +
+					"virtualization.vminterface).",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this FHRP group assignment was last " +
+					"updated.",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, util.Const255),
+				Description:  "The priority of this FHRP group assignment.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this FHRP group assignment.",
+			},
+		},
+	}
+}
+
+func resourceNetboxIpamFHRPGroupAssignmentCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	group, errDiag := brief.GetBriefFHRPGroupRequestFromID(ctx, client,
+		d.Get("fhrp_group_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	priority, err := safecast.ToInt32(d.Get("priority").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewFHRPGroupAssignmentRequest(*group,
+		d.Get("interface_type").(string),
+		int64(d.Get("interface_id").(int)), priority)
+
+	_, response, err := client.IpamAPI.IpamFhrpGroupAssignmentsCreate(
+		ctx).FHRPGroupAssignmentRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxIpamFHRPGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxIpamFHRPGroupAssignmentRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.IpamAPI.
+		IpamFhrpGroupAssignmentsRetrieve(ctx, int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("fhrp_group_id", resource.GetGroup().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("interface_id", resource.GetInterfaceId()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("interface_type",
+		resource.GetInterfaceType()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("priority", resource.GetPriority()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamFHRPGroupAssignmentUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewFHRPGroupAssignmentRequestWithDefaults()
+
+	// Required fields
+	group, errDiag := brief.GetBriefFHRPGroupRequestFromID(ctx, client,
+		d.Get("fhrp_group_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetGroup(*group)
+	resource.SetInterfaceType(d.Get("interface_type").(string))
+	resource.SetInterfaceId(int64(d.Get("interface_id").(int)))
+
+	priority, err := safecast.ToInt32(d.Get("priority").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetPriority(priority)
+
+	if _, response, err := client.IpamAPI.IpamFhrpGroupAssignmentsUpdate(ctx,
+		int32(resourceID)).FHRPGroupAssignmentRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxIpamFHRPGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxIpamFHRPGroupAssignmentDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxIpamFHRPGroupAssignmentExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.IpamAPI.IpamFhrpGroupAssignmentsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamFHRPGroupAssignmentExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.IpamAPI.IpamFhrpGroupAssignmentsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,162 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxIpamFhrpGroupAssignment = "" +
+	"netbox_ipam_fhrp_group_assignment.test"
+
+func TestAccNetboxIpamFhrpGroupAssignmentMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxIpamFhrpGroupAssignment,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupAssignmentFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxIpamFhrpGroupAssignment,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupAssignmentMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroupAssignment),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "fhrpassign-{{ .namesuffix }}"
+		slug = "fhrpassign-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "fhrpassign-{{ .namesuffix }}"
+		slug            = "fhrpassign-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "fhrpassign-{{ .namesuffix }}"
+		slug = "fhrpassign-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "fhrpassign-{{ .namesuffix }}"
+		slug = "fhrpassign-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "fhrpassign-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth0"
+		type      = "1000base-t"
+	}
+
+	resource "netbox_ipam_fhrp_group" "test" {
+		protocol = "vrrp3"
+		group_id = 20
+	}
+
+	resource "netbox_ipam_fhrp_group_assignment" "test" {
+		fhrp_group_id  = netbox_ipam_fhrp_group.test.id
+		interface_type = "dcim.interface"
+		interface_id   = netbox_dcim_interface.test.id
+		priority       = {{ if eq .resourcefull "true" }}200{{ else }}100{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
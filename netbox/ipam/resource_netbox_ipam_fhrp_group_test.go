@@ -0,0 +1,141 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxIpamFhrpGroup = "netbox_ipam_fhrp_group.test"
+
+func TestAccNetboxIpamFhrpGroupMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroup),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxIpamFhrpGroup,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroup),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxIpamFhrpGroup,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamFhrpGroup),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "fhrpgroup-{{ .namesuffix }}"
+		slug = "fhrpgroup-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_ipam_fhrp_group" "test" {
+		protocol = "vrrp3"
+		group_id = 10
+
+		{{ if eq .resourcefull "true" }}
+		auth_key    = "fhrpgroup-{{ .namesuffix }}"
+		auth_type   = "md5"
+		comments    = <<-EOT
+		Test FHRP group
+		EOT
+		description = "Test FHRP group"
+		name        = "fhrpgroup-{{ .namesuffix }}"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -28,6 +28,7 @@ func ResourceNetboxIpamVlan() *schema.Resource {
 		UpdateContext: resourceNetboxIpamVlanUpdate,
 		DeleteContext: resourceNetboxIpamVlanDelete,
 		Exists:        resourceNetboxIpamVlanExists,
+		CustomizeDiff: resourceNetboxIpamVlanCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -57,9 +58,11 @@ func ResourceNetboxIpamVlan() *schema.Resource {
 				Description: "Last date when this vlan was updated.",
 			},
 			"vlan_group_id": {
-				Type:          schema.TypeInt,
-				Optional:      true,
-				Description:   "ID of the group where this vlan belongs to.",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the group where this vlan belongs to. " +
+					"The vlan is recreated when the group changes and " +
+					"vlan_id is not set.",
 				ConflictsWith: []string{"site_id"},
 			},
 			"name": {
@@ -94,14 +97,33 @@ func ResourceNetboxIpamVlan() *schema.Resource {
 				Description: "ID of the tenant where this vlan is attached.",
 			},
 			"vlan_id": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The ID of the vlan (vlan tag).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"vlan_id", "vlan_group_id"},
+				Description: "The ID of the vlan (vlan tag). The next " +
+					"available ID of the vlan group is allocated if not set.",
 			},
 		},
 	}
 }
 
+// The vlan ID allocated from a vlan group is only available in this group,
+// a new vlan is allocated from the new group when it changes
+func resourceNetboxIpamVlanCustomizeDiff(_ context.Context,
+	d *schema.ResourceDiff, _ any) error {
+
+	if d.Id() == "" || !d.HasChange("vlan_group_id") {
+		return nil
+	}
+
+	if d.GetRawConfig().GetAttr("vlan_id").IsNull() {
+		return d.ForceNew("vlan_group_id")
+	}
+
+	return nil
+}
+
 func resourceNetboxIpamVlanCreate(ctx context.Context,
 	d *schema.ResourceData, m any) diag.Diagnostics {
 
@@ -120,12 +142,6 @@ func resourceNetboxIpamVlanCreate(ctx context.Context,
 	newResource.SetName(name)
 	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
 
-	vid32, err := safecast.ToInt32(d.Get("vlan_id").(int))
-	if err != nil {
-		return util.GenerateErrorMessage(nil, err)
-	}
-	newResource.SetVid(vid32)
-
 	s, err := netbox.NewPatchedWritableVLANRequestStatusFromValue(status)
 	if err != nil {
 		return util.GenerateErrorMessage(nil, err)
@@ -164,6 +180,29 @@ func resourceNetboxIpamVlanCreate(ctx context.Context,
 		newResource.SetTenant(*b)
 	}
 
+	// The vlan ID is picked by Netbox within the range of the vlan group
+	if _, ok := d.GetOk("vlan_id"); !ok {
+		groupID32, err := safecast.ToInt32(d.Get("vlan_group_id").(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+
+		resourceID, errDiag := createNewAvailableVLAN(ctx, client, groupID32,
+			*newResource)
+		if errDiag != nil {
+			return errDiag
+		}
+
+		d.SetId(fmt.Sprintf("%d", resourceID))
+		return resourceNetboxIpamVlanRead(ctx, d, m)
+	}
+
+	vid32, err := safecast.ToInt32(d.Get("vlan_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetVid(vid32)
+
 	_, response, err := client.IpamAPI.IpamVlansCreate(
 		ctx).WritableVLANRequest(*newResource).Execute()
 	if response.StatusCode != util.Const201 && err != nil {
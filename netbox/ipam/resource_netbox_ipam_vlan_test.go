@@ -104,6 +104,41 @@ func TestAccNetboxIpamVlanMinimalFullMinimal(t *testing.T) {
 	})
 }
 
+func TestAccNetboxIpamVlanFromGroup(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamVlanFromGroupConfig(
+					nameSuffix, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamVlan),
+					resource.TestCheckResourceAttr(resourceNameIpamVlan,
+						"vlan_id", "100"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamVlanFromGroupConfig(
+					nameSuffix, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamVlan),
+					resource.TestCheckResourceAttr(resourceNameIpamVlan,
+						"vlan_id", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamVlan,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckNetboxIpamVlanConfig(nameSuffix string,
 	resourceFull, extraResources bool) string {
 
@@ -161,3 +196,29 @@ func testAccCheckNetboxIpamVlanConfig(nameSuffix string,
 	}
 	return util.RenderTemplate(template, data)
 }
+
+func testAccCheckNetboxIpamVlanFromGroupConfig(nameSuffix string,
+	resourceFull bool) string {
+
+	const template = `
+	resource "netbox_ipam_vlan_group" "test" {
+		name    = "ipamvlan-{{ .namesuffix }}"
+		slug    = "ipamvlan-{{ .namesuffix }}"
+		min_vid = 100
+		max_vid = 199
+	}
+
+	resource "netbox_ipam_vlan" "test" {
+		name          = "ipamvlan-{{ .namesuffix }}"
+		vlan_group_id = netbox_ipam_vlan_group.test.id
+		{{ if eq .resourcefull "true" }}
+		description = "Test Vlan from group"
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":   nameSuffix,
+		"resourcefull": strconv.FormatBool(resourceFull),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -5,6 +5,10 @@ package ipam
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	netbox "github.com/smutel/go-netbox/v4"
@@ -58,3 +62,80 @@ func getNewAvailablePrefix(ctx context.Context,
 
 	return &resources[0], nil
 }
+
+func createNewAvailableASN(ctx context.Context,
+	client *netbox.APIClient, id int32, request netbox.ASNRequest) (int32,
+	diag.Diagnostics) {
+
+	_, response, err := client.IpamAPI.IpamAsnRangesAvailableAsnsCreate(
+		ctx, id).ASNRequest([]netbox.ASNRequest{request}).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return 0, util.GenerateErrorMessage(response, err)
+	}
+
+	return getAllocatedID(response,
+		errors.New("No ASN allocated in the ASN range"))
+}
+
+func createNewAvailableVLAN(ctx context.Context,
+	client *netbox.APIClient, id int32,
+	resource netbox.WritableVLANRequest) (int32, diag.Diagnostics) {
+
+	// The available VLANs endpoint expects a VLANRequest which only differs
+	// from a WritableVLANRequest by the type of its status, both types share
+	// the same values
+	var status *netbox.IPRangeStatusValue
+	if resource.HasStatus() {
+		s, err := netbox.NewIPRangeStatusValueFromValue(
+			string(resource.GetStatus()))
+		if err != nil {
+			return 0, util.GenerateErrorMessage(nil, err)
+		}
+		status = s
+	}
+
+	request := netbox.VLANRequest{
+		Site:         resource.Site,
+		Group:        resource.Group,
+		Vid:          resource.Vid,
+		Name:         resource.Name,
+		Tenant:       resource.Tenant,
+		Status:       status,
+		Role:         resource.Role,
+		Description:  resource.Description,
+		Comments:     resource.Comments,
+		Tags:         resource.Tags,
+		CustomFields: resource.CustomFields,
+	}
+
+	_, response, err := client.IpamAPI.IpamVlanGroupsAvailableVlansCreate(
+		ctx, id).VLANRequest([]netbox.VLANRequest{request}).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return 0, util.GenerateErrorMessage(response, err)
+	}
+
+	return getAllocatedID(response,
+		errors.New("No VLAN allocated in the VLAN group"))
+}
+
+// getAllocatedID returns the ID of the first object of the list returned by
+// an endpoint allocating available objects
+func getAllocatedID(response *http.Response, errNotAllocated error) (int32,
+	diag.Diagnostics) {
+
+	byteValue, err := io.ReadAll(response.Body)
+	if err != nil {
+		return 0, util.GenerateErrorMessage(response, err)
+	}
+
+	var resources []util.GenericResponse
+	if err := json.Unmarshal(byteValue, &resources); err != nil {
+		return 0, util.GenerateErrorMessage(response, err)
+	}
+
+	if len(resources) == 0 || resources[0].ID == 0 {
+		return 0, util.GenerateErrorMessage(response, errNotAllocated)
+	}
+
+	return resources[0].ID, nil
+}
@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/circuits"
 	"github.com/smutel/terraform-provider-netbox/v8/netbox/dcim"
 	"github.com/smutel/terraform-provider-netbox/v8/netbox/extras"
 	"github.com/smutel/terraform-provider-netbox/v8/netbox/ipam"
 	"github.com/smutel/terraform-provider-netbox/v8/netbox/json"
 	"github.com/smutel/terraform-provider-netbox/v8/netbox/tenancy"
 	"github.com/smutel/terraform-provider-netbox/v8/netbox/virtualization"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/vpn"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/wireless"
 )
 
 const authHeaderName = "Authorization"
@@ -156,21 +159,34 @@ func Provider() *schema.Provider {
 			"netbox_json_vpn_ipsec_policies_list":                 json.DataNetboxJSONVpnIpsecPoliciesList(),
 			"netbox_json_vpn_ipsec_profiles_list":                 json.DataNetboxJSONVpnIpsecProfilesList(),
 			"netbox_json_vpn_ipsec_proposals_list":                json.DataNetboxJSONVpnIpsecProposalsList(),
+			"netbox_json_vpn_l2vpns_list":                         json.DataNetboxJSONVpnL2vpnsList(),
+			"netbox_json_vpn_l2vpn_terminations_list":             json.DataNetboxJSONVpnL2vpnTerminationsList(),
 			"netbox_json_vpn_tunnel_groups_list":                  json.DataNetboxJSONVpnTunnelGroupsList(),
 			"netbox_json_vpn_tunnels_list":                        json.DataNetboxJSONVpnTunnelsList(),
 			"netbox_json_vpn_tunnel_terminations_list":            json.DataNetboxJSONVpnTunnelTerminationsList(),
 			"netbox_json_wireless_wireless_lan_groups_list":       json.DataNetboxJSONWirelessWirelessLanGroupsList(),
 			"netbox_json_wireless_wireless_lans_list":             json.DataNetboxJSONWirelessWirelessLansList(),
 			"netbox_json_wireless_wireless_links_list":            json.DataNetboxJSONWirelessWirelessLinksList(),
+			"netbox_circuits_circuit":                             circuits.DataNetboxCircuitsCircuit(),
+			"netbox_circuits_circuit_type":                        circuits.DataNetboxCircuitsCircuitType(),
+			"netbox_circuits_provider":                            circuits.DataNetboxCircuitsProvider(),
+			"netbox_circuits_provider_account":                    circuits.DataNetboxCircuitsProviderAccount(),
+			"netbox_circuits_provider_network":                    circuits.DataNetboxCircuitsProviderNetwork(),
+			"netbox_dcim_cable_trace":                             dcim.DataNetboxDcimCableTrace(),
+			"netbox_dcim_device":                                  dcim.DataNetboxDcimDevice(),
 			"netbox_dcim_device_role":                             dcim.DataNetboxDcimDeviceRole(),
+			"netbox_dcim_interface":                               dcim.DataNetboxDcimInterface(),
 			"netbox_dcim_location":                                dcim.DataNetboxDcimLocation(),
 			"netbox_dcim_manufacturer":                            dcim.DataNetboxDcimManufacturer(),
 			"netbox_dcim_platform":                                dcim.DataNetboxDcimPlatform(),
+			"netbox_dcim_power_feed_utilization":                  dcim.DataNetboxDcimPowerFeedUtilization(),
 			"netbox_dcim_rack":                                    dcim.DataNetboxDcimRack(),
+			"netbox_dcim_rack_elevation":                          dcim.DataNetboxDcimRackElevation(),
 			"netbox_dcim_rack_role":                               dcim.DataNetboxDcimRackRole(),
 			"netbox_dcim_region":                                  dcim.DataNetboxDcimRegion(),
 			"netbox_dcim_site":                                    dcim.DataNetboxDcimSite(),
 			"netbox_dcim_site_group":                              dcim.DataNetboxDcimSiteGroup(),
+			"netbox_dcim_virtual_device_context":                  dcim.DataNetboxDcimVirtualDeviceContext(),
 			"netbox_extras_custom_field":                          extras.DataNetboxExtrasCustomField(),
 			"netbox_extras_tag":                                   extras.DataNetboxExtrasTag(),
 			"netbox_ipam_aggregate":                               ipam.DataNetboxIpamAggregate(),
@@ -195,22 +211,55 @@ func Provider() *schema.Provider {
 			"netbox_virtualization_cluster_type":                  virtualization.DataNetboxVirtualizationClusterType(),
 			"netbox_virtualization_interface":                     virtualization.DataNetboxVirtualizationInterface(),
 			"netbox_virtualization_vm":                            virtualization.DataNetboxVirtualizationVM(),
+			"netbox_vpn_l2vpn":                                    vpn.DataNetboxVpnL2vpn(),
+			"netbox_vpn_l2vpn_termination":                        vpn.DataNetboxVpnL2vpnTermination(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
+			"netbox_circuits_circuit":               circuits.ResourceNetboxCircuitsCircuit(),
+			"netbox_circuits_circuit_termination":   circuits.ResourceNetboxCircuitsCircuitTermination(),
+			"netbox_circuits_circuit_type":          circuits.ResourceNetboxCircuitsCircuitType(),
+			"netbox_circuits_provider":              circuits.ResourceNetboxCircuitsProvider(),
+			"netbox_circuits_provider_account":      circuits.ResourceNetboxCircuitsProviderAccount(),
+			"netbox_circuits_provider_network":      circuits.ResourceNetboxCircuitsProviderNetwork(),
+			"netbox_dcim_cable":                     dcim.ResourceNetboxDcimCable(),
+			"netbox_dcim_console_port":              dcim.ResourceNetboxDcimConsolePort(),
+			"netbox_dcim_console_server_port":       dcim.ResourceNetboxDcimConsoleServerPort(),
+			"netbox_dcim_device":                    dcim.ResourceNetboxDcimDevice(),
+			"netbox_dcim_device_interfaces":         dcim.ResourceNetboxDcimDeviceInterfaces(),
 			"netbox_dcim_device_role":               dcim.ResourceNetboxDcimDeviceRole(),
+			"netbox_dcim_device_type":               dcim.ResourceNetboxDcimDeviceType(),
+			"netbox_dcim_device_type_library":       dcim.ResourceNetboxDcimDeviceTypeLibrary(),
+			"netbox_dcim_front_port":                dcim.ResourceNetboxDcimFrontPort(),
+			"netbox_dcim_interface":                 dcim.ResourceNetboxDcimInterface(),
+			"netbox_dcim_inventory_item":            dcim.ResourceNetboxDcimInventoryItem(),
+			"netbox_dcim_inventory_item_role":       dcim.ResourceNetboxDcimInventoryItemRole(),
 			"netbox_dcim_location":                  dcim.ResourceNetboxDcimLocation(),
 			"netbox_dcim_manufacturer":              dcim.ResourceNetboxDcimManufacturer(),
+			"netbox_dcim_module":                    dcim.ResourceNetboxDcimModule(),
+			"netbox_dcim_module_bay":                dcim.ResourceNetboxDcimModuleBay(),
+			"netbox_dcim_module_type":               dcim.ResourceNetboxDcimModuleType(),
 			"netbox_dcim_platform":                  dcim.ResourceNetboxDcimPlatform(),
+			"netbox_dcim_power_feed":                dcim.ResourceNetboxDcimPowerFeed(),
+			"netbox_dcim_power_outlet":              dcim.ResourceNetboxDcimPowerOutlet(),
+			"netbox_dcim_power_panel":               dcim.ResourceNetboxDcimPowerPanel(),
+			"netbox_dcim_power_port":                dcim.ResourceNetboxDcimPowerPort(),
 			"netbox_dcim_rack":                      dcim.ResourceNetboxDcimRack(),
+			"netbox_dcim_rack_reservation":          dcim.ResourceNetboxDcimRackReservation(),
 			"netbox_dcim_rack_role":                 dcim.ResourceNetboxDcimRackRole(),
+			"netbox_dcim_rear_port":                 dcim.ResourceNetboxDcimRearPort(),
 			"netbox_dcim_region":                    dcim.ResourceNetboxDcimRegion(),
 			"netbox_dcim_site":                      dcim.ResourceNetboxDcimSite(),
 			"netbox_dcim_site_group":                dcim.ResourceNetboxDcimSiteGroup(),
+			"netbox_dcim_virtual_chassis":           dcim.ResourceNetboxDcimVirtualChassis(),
+			"netbox_dcim_virtual_device_context":    dcim.ResourceNetboxDcimVirtualDeviceContext(),
 			"netbox_extras_custom_field":            extras.ResourceNetboxExtrasCustomField(),
 			"netbox_extras_custom_field_choice_set": extras.ResourceNetboxExtrasCustomFieldChoiceSet(),
 			"netbox_extras_tag":                     extras.ResourceNetboxExtrasTag(),
 			"netbox_ipam_aggregate":                 ipam.ResourceNetboxIpamAggregate(),
 			"netbox_ipam_asn":                       ipam.ResourceNetboxIpamASN(),
+			"netbox_ipam_asn_range":                 ipam.ResourceNetboxIpamASNRange(),
+			"netbox_ipam_fhrp_group":                ipam.ResourceNetboxIpamFHRPGroup(),
+			"netbox_ipam_fhrp_group_assignment":     ipam.ResourceNetboxIpamFHRPGroupAssignment(),
 			"netbox_ipam_ip_addresses":              ipam.ResourceNetboxIpamIPAddresses(),
 			"netbox_ipam_ip_range":                  ipam.ResourceNetboxIpamIPRange(),
 			"netbox_ipam_prefix":                    ipam.ResourceNetboxIpamPrefix(),
@@ -233,6 +282,19 @@ func Provider() *schema.Provider {
 			"netbox_virtualization_interface":       virtualization.ResourceNetboxVirtualizationInterface(),
 			"netbox_virtualization_vm":              virtualization.ResourceNetboxVirtualizationVM(),
 			"netbox_virtualization_vm_primary_ip":   virtualization.ResourceNetboxVirtualizationVMPrimaryIP(),
+			"netbox_vpn_ike_policy":                 vpn.ResourceNetboxVpnIkePolicy(),
+			"netbox_vpn_ike_proposal":               vpn.ResourceNetboxVpnIkeProposal(),
+			"netbox_vpn_ipsec_policy":               vpn.ResourceNetboxVpnIpsecPolicy(),
+			"netbox_vpn_ipsec_profile":              vpn.ResourceNetboxVpnIpsecProfile(),
+			"netbox_vpn_ipsec_proposal":             vpn.ResourceNetboxVpnIpsecProposal(),
+			"netbox_vpn_l2vpn":                      vpn.ResourceNetboxVpnL2vpn(),
+			"netbox_vpn_l2vpn_termination":          vpn.ResourceNetboxVpnL2vpnTermination(),
+			"netbox_vpn_tunnel":                     vpn.ResourceNetboxVpnTunnel(),
+			"netbox_vpn_tunnel_group":               vpn.ResourceNetboxVpnTunnelGroup(),
+			"netbox_vpn_tunnel_termination":         vpn.ResourceNetboxVpnTunnelTermination(),
+			"netbox_wireless_lan":                   wireless.ResourceNetboxWirelessLan(),
+			"netbox_wireless_lan_group":             wireless.ResourceNetboxWirelessLanGroup(),
+			"netbox_wireless_link":                  wireless.ResourceNetboxWirelessLink(),
 		},
 		ConfigureContextFunc: configureProvider,
 	}
@@ -0,0 +1,91 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxVpnL2vpn() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about L2VPN from netbox.",
+		ReadContext: dataNetboxVpnL2vpnRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this L2VPN.",
+			},
+			"identifier": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"identifier", "name"},
+				Description:  "The numeric identifier of the L2VPN.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"identifier", "name"},
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of the L2VPN.",
+			},
+		},
+	}
+}
+
+func dataNetboxVpnL2vpnRead(ctx context.Context, d *schema.ResourceData,
+	m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	request := client.VpnAPI.VpnL2vpnsList(ctx)
+
+	if identifier, exist := d.GetOk("identifier"); exist {
+		identifier32, err := safecast.ToInt32(identifier.(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		request = request.Identifier([]int32{identifier32})
+	}
+
+	if name, exist := d.GetOk("name"); exist {
+		request = request.Name([]string{name.(string)})
+	}
+
+	resource, response, err := request.Execute()
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if resource.GetCount() < 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned no results. "+
+				"Please change your search criteria and try again."))
+
+	} else if resource.GetCount() > 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned more than one result. "+
+				"Please try a more specific search criteria."))
+	}
+
+	r := resource.Results[0]
+	d.SetId(fmt.Sprintf("%d", r.GetId()))
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(r.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
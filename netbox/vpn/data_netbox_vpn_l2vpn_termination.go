@@ -0,0 +1,96 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func DataNetboxVpnL2vpnTermination() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about L2VPN termination from netbox.",
+		ReadContext: dataNetboxVpnL2vpnTerminationRead,
+
+		Schema: map[string]*schema.Schema{
+			"assigned_object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the object (VLAN or interface) assigned " +
+					"to the L2VPN termination.",
+			},
+			"assigned_object_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					l2vpnTerminationObjectTypes, false),
+				Description: "The type of the object assigned to the L2VPN " +
+					"termination (ipam.vlan, dcim.interface or " +
+					"virtualization.vminterface).",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this L2VPN termination.",
+			},
+			"l2vpn_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the L2VPN of this L2VPN termination.",
+			},
+		},
+	}
+}
+
+func dataNetboxVpnL2vpnTerminationRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	objectID, err := safecast.ToInt32(d.Get("assigned_object_id").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	objectType := d.Get("assigned_object_type").(string)
+
+	resource, response, err := client.VpnAPI.VpnL2vpnTerminationsList(
+		ctx).AssignedObjectType(objectType).AssignedObjectId(
+		[]int32{objectID}).Execute()
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if resource.GetCount() < 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned no results. "+
+				"Please change your search criteria and try again."))
+
+	} else if resource.GetCount() > 1 {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Your query returned more than one result. "+
+				"Please try a more specific search criteria."))
+	}
+
+	r := resource.Results[0]
+	d.SetId(fmt.Sprintf("%d", r.GetId()))
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(r.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("l2vpn_id", r.GetL2vpn().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
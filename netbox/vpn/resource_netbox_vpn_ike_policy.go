@@ -0,0 +1,383 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxVpnIkePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an IKE policy within Netbox.",
+		CreateContext: resourceNetboxVpnIkePolicyCreate,
+		ReadContext:   resourceNetboxVpnIkePolicyRead,
+		UpdateContext: resourceNetboxVpnIkePolicyUpdate,
+		DeleteContext: resourceNetboxVpnIkePolicyDelete,
+		Exists:        resourceNetboxVpnIkePolicyExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this IKE policy.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this IKE policy.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IKE policy was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this IKE policy.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IKE policy was last updated.",
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedPatchedWritableIKEPolicyRequestModeEnumValues),
+					false),
+				Description: "The mode among aggressive or main of this IKE " +
+					"policy.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this IKE policy.",
+			},
+			"preshared_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const100),
+				Description:  "The pre-shared key of this IKE policy.",
+			},
+			"proposals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "IDs of the IKE proposals of this IKE policy.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this IKE policy.",
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
+				ValidateFunc: validation.IntInSlice(util.EnumToListofInts(
+					netbox.AllowedPatchedWritableIKEPolicyRequestVersionEnumValues)),
+				Description: "The IKE version among 1 or 2 (2 by default) of " +
+					"this IKE policy.",
+			},
+		},
+	}
+}
+
+func resourceNetboxVpnIkePolicyCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	proposals, err := util.ExpandToInt32Slice(
+		d.Get("proposals").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	mode, err := netbox.NewPatchedWritableIKEPolicyRequestModeFromValue(
+		d.Get("mode").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	version32, err := safecast.ToInt32(d.Get("version").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	version, err := netbox.NewPatchedWritableIKEPolicyRequestVersionFromValue(
+		version32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableIKEPolicyRequest(d.Get("name").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetMode(*mode)
+	newResource.SetPresharedKey(d.Get("preshared_key").(string))
+	newResource.SetProposals(proposals)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	newResource.SetVersion(*version)
+
+	_, response, err := client.VpnAPI.VpnIkePoliciesCreate(
+		ctx).WritableIKEPolicyRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxVpnIkePolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkePolicyRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.VpnAPI.VpnIkePoliciesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("mode", resource.GetMode().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("preshared_key", resource.GetPresharedKey()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	proposals := []int32{}
+	for _, proposal := range resource.GetProposals() {
+		proposals = append(proposals, proposal.GetId())
+	}
+
+	if err = d.Set("proposals", proposals); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	version := resource.GetVersion()
+	if err = d.Set("version", int(version.GetValue())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnIkePolicyUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableIKEPolicyRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("mode") {
+		mode, err := netbox.NewPatchedWritableIKEPolicyRequestModeFromValue(
+			d.Get("mode").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetMode(*mode)
+	}
+
+	if d.HasChange("preshared_key") {
+		resource.SetPresharedKey(d.Get("preshared_key").(string))
+	}
+
+	if d.HasChange("proposals") {
+		proposals, err := util.ExpandToInt32Slice(
+			d.Get("proposals").(*schema.Set).List())
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetProposals(proposals)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("version") {
+		version32, err := safecast.ToInt32(d.Get("version").(int))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		version, err := netbox.
+			NewPatchedWritableIKEPolicyRequestVersionFromValue(version32)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetVersion(*version)
+	}
+
+	if _, response, err := client.VpnAPI.VpnIkePoliciesUpdate(ctx,
+		int32(resourceID)).WritableIKEPolicyRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxVpnIkePolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkePolicyDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxVpnIkePolicyExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.VpnAPI.VpnIkePoliciesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnIkePolicyExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.VpnAPI.VpnIkePoliciesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,149 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnIkePolicy = "netbox_vpn_ike_policy.test"
+
+func TestAccNetboxVpnIkePolicyMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIkePolicyConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkePolicy),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIkePolicy,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIkePolicyFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIkePolicyConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkePolicy),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIkePolicy,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIkePolicyMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIkePolicyConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkePolicy),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIkePolicyConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkePolicy),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIkePolicyConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkePolicy),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIkePolicyConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkePolicy),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnIkePolicyConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnikepolicy-{{ .namesuffix }}"
+		slug = "vpnikepolicy-{{ .namesuffix }}"
+	}
+
+	resource "netbox_vpn_ike_proposal" "test" {
+		name                  = "vpnikepolicy-{{ .namesuffix }}"
+		authentication_method = "preshared-keys"
+		encryption_algorithm  = "aes-256-cbc"
+		group                 = 14
+	}
+	{{ end }}
+
+	resource "netbox_vpn_ike_policy" "test" {
+		name = "vpnikepolicy-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test IKE policy
+		Multiline
+		EOT
+		description   = "Test IKE policy"
+		mode          = "main"
+		preshared_key = "S3cr3tK3y"
+		proposals     = [netbox_vpn_ike_proposal.test.id]
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		version = 1
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
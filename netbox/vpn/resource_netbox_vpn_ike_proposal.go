@@ -0,0 +1,423 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxVpnIkeProposal() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an IKE proposal within Netbox.",
+		CreateContext: resourceNetboxVpnIkeProposalCreate,
+		ReadContext:   resourceNetboxVpnIkeProposalRead,
+		UpdateContext: resourceNetboxVpnIkeProposalUpdate,
+		DeleteContext: resourceNetboxVpnIkeProposalDelete,
+		Exists:        resourceNetboxVpnIkeProposalExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedAuthenticationEnumValues),
+					false),
+				Description: "The authentication algorithm (hmac-sha1, " +
+					"hmac-sha256, ...) of this IKE proposal.",
+			},
+			"authentication_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(netbox.
+						AllowedIKEProposalAuthenticationMethodValueEnumValues),
+					false),
+				Description: "The authentication method (preshared-keys, " +
+					"certificates, ...) of this IKE proposal.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this IKE proposal.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this IKE proposal.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IKE proposal was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this IKE proposal.",
+			},
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(netbox.
+						AllowedIKEProposalEncryptionAlgorithmValueEnumValues),
+					false),
+				Description: "The encryption algorithm (aes-128-cbc, " +
+					"aes-256-gcm, ...) of this IKE proposal.",
+			},
+			"group": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.IntInSlice(util.EnumToListofInts(
+					netbox.AllowedPatchedWritableIKEProposalRequestGroupEnumValues)),
+				Description: "The Diffie-Hellman group (1, 2, 5, 14, ...) of " +
+					"this IKE proposal.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IKE proposal was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this IKE proposal.",
+			},
+			"sa_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The security association lifetime (in seconds) " +
+					"of this IKE proposal.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this IKE proposal.",
+			},
+		},
+	}
+}
+
+func resourceNetboxVpnIkeProposalCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	method, err := netbox.NewIKEProposalAuthenticationMethodValueFromValue(
+		d.Get("authentication_method").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	encryption, err := netbox.
+		NewIKEProposalEncryptionAlgorithmValueFromValue(
+			d.Get("encryption_algorithm").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	group32, err := safecast.ToInt32(d.Get("group").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	group, err := netbox.NewPatchedWritableIKEProposalRequestGroupFromValue(
+		group32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableIKEProposalRequest(
+		d.Get("name").(string), *method, *encryption, *group)
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	authentication, err := netbox.
+		NewPatchedWritableIKEProposalRequestAuthenticationAlgorithmFromValue(
+			d.Get("authentication_algorithm").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetAuthenticationAlgorithm(*authentication)
+
+	if lifetime := d.Get("sa_lifetime").(int); lifetime != 0 {
+		lifetime32, err := safecast.ToInt32(lifetime)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetSaLifetime(lifetime32)
+	}
+
+	_, response, err := client.VpnAPI.VpnIkeProposalsCreate(
+		ctx).WritableIKEProposalRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxVpnIkeProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkeProposalRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.VpnAPI.VpnIkeProposalsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("authentication_algorithm",
+		resource.GetAuthenticationAlgorithm().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("authentication_method",
+		resource.GetAuthenticationMethod().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("encryption_algorithm",
+		resource.GetEncryptionAlgorithm().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	group := resource.GetGroup()
+	if err = d.Set("group", int(group.GetValue())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("sa_lifetime", resource.GetSaLifetime()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnIkeProposalUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableIKEProposalRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+
+	method, err := netbox.NewIKEProposalAuthenticationMethodValueFromValue(
+		d.Get("authentication_method").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetAuthenticationMethod(*method)
+
+	encryption, err := netbox.
+		NewIKEProposalEncryptionAlgorithmValueFromValue(
+			d.Get("encryption_algorithm").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetEncryptionAlgorithm(*encryption)
+
+	group32, err := safecast.ToInt32(d.Get("group").(int))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	group, err := netbox.NewPatchedWritableIKEProposalRequestGroupFromValue(
+		group32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetGroup(*group)
+
+	if d.HasChange("authentication_algorithm") {
+		authentication, err := netbox.
+			NewPatchedWritableIKEProposalRequestAuthenticationAlgorithmFromValue(
+				d.Get("authentication_algorithm").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAuthenticationAlgorithm(*authentication)
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("sa_lifetime") {
+		if lifetime := d.Get("sa_lifetime").(int); lifetime != 0 {
+			lifetime32, err := safecast.ToInt32(lifetime)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetSaLifetime(lifetime32)
+		} else {
+			resource.SetSaLifetimeNil()
+		}
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.VpnAPI.VpnIkeProposalsUpdate(ctx,
+		int32(resourceID)).WritableIKEProposalRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxVpnIkeProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkeProposalDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxVpnIkeProposalExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.VpnAPI.VpnIkeProposalsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnIkeProposalExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.VpnAPI.VpnIkeProposalsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
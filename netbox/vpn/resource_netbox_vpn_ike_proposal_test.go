@@ -0,0 +1,143 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnIkeProposal = "netbox_vpn_ike_proposal.test"
+
+func TestAccNetboxVpnIkeProposalMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIkeProposalConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkeProposal),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIkeProposal,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIkeProposalFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIkeProposalConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkeProposal),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIkeProposal,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIkeProposalMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIkeProposalConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkeProposal),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIkeProposalConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkeProposal),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIkeProposalConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkeProposal),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIkeProposalConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIkeProposal),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnIkeProposalConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnikeproposal-{{ .namesuffix }}"
+		slug = "vpnikeproposal-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_ike_proposal" "test" {
+		name                  = "vpnikeproposal-{{ .namesuffix }}"
+		authentication_method = "preshared-keys"
+		encryption_algorithm  = "aes-256-cbc"
+		group                 = 14
+
+		{{ if eq .resourcefull "true" }}
+		authentication_algorithm = "hmac-sha256"
+		comments                 = <<-EOT
+		Comments for Test IKE proposal
+		Multiline
+		EOT
+		description = "Test IKE proposal"
+		sa_lifetime = 28800
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
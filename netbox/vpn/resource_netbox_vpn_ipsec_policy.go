@@ -0,0 +1,343 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxVpnIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an IPSec policy within Netbox.",
+		CreateContext: resourceNetboxVpnIpsecPolicyCreate,
+		ReadContext:   resourceNetboxVpnIpsecPolicyRead,
+		UpdateContext: resourceNetboxVpnIpsecPolicyUpdate,
+		DeleteContext: resourceNetboxVpnIpsecPolicyDelete,
+		Exists:        resourceNetboxVpnIpsecPolicyExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this IPSec policy.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this IPSec policy.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IPSec policy was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this IPSec policy.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IPSec policy was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this IPSec policy.",
+			},
+			"pfs_group": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ValidateFunc: validation.IntInSlice(util.EnumToListofInts(
+					netbox.AllowedPatchedWritableIPSecPolicyRequestPfsGroupEnumValues)),
+				Description: "The Diffie-Hellman group for Perfect Forward " +
+					"Secrecy (1, 2, 5, 14, ...) of this IPSec policy.",
+			},
+			"proposals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "IDs of the IPSec proposals of this IPSec policy.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this IPSec policy.",
+			},
+		},
+	}
+}
+
+func resourceNetboxVpnIpsecPolicyCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	proposals, err := util.ExpandToInt32Slice(
+		d.Get("proposals").(*schema.Set).List())
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableIPSecPolicyRequest(d.Get("name").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetProposals(proposals)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if pfsGroup := d.Get("pfs_group").(int); pfsGroup != 0 {
+		pfsGroup32, err := safecast.ToInt32(pfsGroup)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		g, err := netbox.NewPatchedWritableIPSecPolicyRequestPfsGroupFromValue(
+			pfsGroup32)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetPfsGroup(*g)
+	}
+
+	_, response, err := client.VpnAPI.VpnIpsecPoliciesCreate(
+		ctx).WritableIPSecPolicyRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxVpnIpsecPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecPolicyRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.VpnAPI.VpnIpsecPoliciesRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	pfsGroup := resource.GetPfsGroup()
+	if err = d.Set("pfs_group", int(pfsGroup.GetValue())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	proposals := []int32{}
+	for _, proposal := range resource.GetProposals() {
+		proposals = append(proposals, proposal.GetId())
+	}
+
+	if err = d.Set("proposals", proposals); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnIpsecPolicyUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableIPSecPolicyRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("pfs_group") {
+		if pfsGroup := d.Get("pfs_group").(int); pfsGroup != 0 {
+			pfsGroup32, err := safecast.ToInt32(pfsGroup)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			g, err := netbox.
+				NewPatchedWritableIPSecPolicyRequestPfsGroupFromValue(
+					pfsGroup32)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetPfsGroup(*g)
+		} else {
+			resource.SetPfsGroupNil()
+		}
+	}
+
+	if d.HasChange("proposals") {
+		proposals, err := util.ExpandToInt32Slice(
+			d.Get("proposals").(*schema.Set).List())
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetProposals(proposals)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.VpnAPI.VpnIpsecPoliciesUpdate(ctx,
+		int32(resourceID)).WritableIPSecPolicyRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxVpnIpsecPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecPolicyDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxVpnIpsecPolicyExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.VpnAPI.VpnIpsecPoliciesDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnIpsecPolicyExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.VpnAPI.VpnIpsecPoliciesRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,145 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnIpsecPolicy = "netbox_vpn_ipsec_policy.test"
+
+func TestAccNetboxVpnIpsecPolicyMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIpsecPolicy,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIpsecPolicyFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIpsecPolicy,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIpsecPolicyMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecPolicy),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecPolicy),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecPolicy),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecPolicy),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnIpsecPolicyConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnipsecpolicy-{{ .namesuffix }}"
+		slug = "vpnipsecpolicy-{{ .namesuffix }}"
+	}
+
+	resource "netbox_vpn_ipsec_proposal" "test" {
+		name                 = "vpnipsecpolicy-{{ .namesuffix }}"
+		encryption_algorithm = "aes-256-gcm"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_ipsec_policy" "test" {
+		name = "vpnipsecpolicy-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test IPSec policy
+		Multiline
+		EOT
+		description = "Test IPSec policy"
+		pfs_group   = 14
+		proposals   = [netbox_vpn_ipsec_proposal.test.id]
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,148 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnIpsecProfile = "netbox_vpn_ipsec_profile.test"
+
+func TestAccNetboxVpnIpsecProfileMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProfile),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIpsecProfile,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIpsecProfileFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProfile),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIpsecProfile,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIpsecProfileMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProfile),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProfile),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProfile),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProfile),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnIpsecProfileConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_vpn_ike_policy" "test" {
+		name = "vpnipsecprofile-{{ .namesuffix }}"
+	}
+
+	resource "netbox_vpn_ipsec_policy" "test" {
+		name = "vpnipsecprofile-{{ .namesuffix }}"
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnipsecprofile-{{ .namesuffix }}"
+		slug = "vpnipsecprofile-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_ipsec_profile" "test" {
+		name            = "vpnipsecprofile-{{ .namesuffix }}"
+		mode            = "esp"
+		ike_policy_id   = netbox_vpn_ike_policy.test.id
+		ipsec_policy_id = netbox_vpn_ipsec_policy.test.id
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test IPSec profile
+		Multiline
+		EOT
+		description = "Test IPSec profile"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,398 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxVpnIpsecProposal() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an IPSec proposal within Netbox.",
+		CreateContext: resourceNetboxVpnIpsecProposalCreate,
+		ReadContext:   resourceNetboxVpnIpsecProposalRead,
+		UpdateContext: resourceNetboxVpnIpsecProposalUpdate,
+		DeleteContext: resourceNetboxVpnIpsecProposalDelete,
+		Exists:        resourceNetboxVpnIpsecProposalExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedAuthenticationEnumValues),
+					false),
+				AtLeastOneOf: []string{"authentication_algorithm",
+					"encryption_algorithm"},
+				Description: "The authentication algorithm (hmac-sha1, " +
+					"hmac-sha256, ...) of this IPSec proposal.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this IPSec proposal.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this IPSec proposal.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IPSec proposal was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this IPSec proposal.",
+			},
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedEncryptionEnumValues),
+					false),
+				AtLeastOneOf: []string{"authentication_algorithm",
+					"encryption_algorithm"},
+				Description: "The encryption algorithm (aes-128-cbc, " +
+					"aes-256-gcm, ...) of this IPSec proposal.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this IPSec proposal was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this IPSec proposal.",
+			},
+			"sa_lifetime_data": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The security association lifetime (in " +
+					"kilobytes) of this IPSec proposal.",
+			},
+			"sa_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The security association lifetime (in seconds) " +
+					"of this IPSec proposal.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this IPSec proposal.",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnIpsecProposalCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	authentication, err := netbox.NewAuthenticationFromValue(
+		d.Get("authentication_algorithm").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	encryption, err := netbox.NewEncryptionFromValue(
+		d.Get("encryption_algorithm").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableIPSecProposalRequest(
+		d.Get("name").(string))
+	newResource.SetAuthenticationAlgorithm(*authentication)
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetEncryptionAlgorithm(*encryption)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if lifetime := d.Get("sa_lifetime_data").(int); lifetime != 0 {
+		lifetime32, err := safecast.ToInt32(lifetime)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetSaLifetimeData(lifetime32)
+	}
+
+	if lifetime := d.Get("sa_lifetime_seconds").(int); lifetime != 0 {
+		lifetime32, err := safecast.ToInt32(lifetime)
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		newResource.SetSaLifetimeSeconds(lifetime32)
+	}
+
+	_, response, err := client.VpnAPI.VpnIpsecProposalsCreate(
+		ctx).WritableIPSecProposalRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxVpnIpsecProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecProposalRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.VpnAPI.VpnIpsecProposalsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("authentication_algorithm",
+		resource.GetAuthenticationAlgorithm().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("encryption_algorithm",
+		resource.GetEncryptionAlgorithm().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("sa_lifetime_data",
+		resource.GetSaLifetimeData()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("sa_lifetime_seconds",
+		resource.GetSaLifetimeSeconds()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnIpsecProposalUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableIPSecProposalRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+
+	if d.HasChange("authentication_algorithm") {
+		authentication, err := netbox.NewAuthenticationFromValue(
+			d.Get("authentication_algorithm").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAuthenticationAlgorithm(*authentication)
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("encryption_algorithm") {
+		encryption, err := netbox.NewEncryptionFromValue(
+			d.Get("encryption_algorithm").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetEncryptionAlgorithm(*encryption)
+	}
+
+	if d.HasChange("sa_lifetime_data") {
+		if lifetime := d.Get("sa_lifetime_data").(int); lifetime != 0 {
+			lifetime32, err := safecast.ToInt32(lifetime)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetSaLifetimeData(lifetime32)
+		} else {
+			resource.SetSaLifetimeDataNil()
+		}
+	}
+
+	if d.HasChange("sa_lifetime_seconds") {
+		if lifetime := d.Get("sa_lifetime_seconds").(int); lifetime != 0 {
+			lifetime32, err := safecast.ToInt32(lifetime)
+			if err != nil {
+				return util.GenerateErrorMessage(nil, err)
+			}
+			resource.SetSaLifetimeSeconds(lifetime32)
+		} else {
+			resource.SetSaLifetimeSecondsNil()
+		}
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.VpnAPI.VpnIpsecProposalsUpdate(ctx,
+		int32(resourceID)).WritableIPSecProposalRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxVpnIpsecProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecProposalDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxVpnIpsecProposalExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.VpnAPI.VpnIpsecProposalsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnIpsecProposalExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.VpnAPI.VpnIpsecProposalsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,142 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnIpsecProposal = "netbox_vpn_ipsec_proposal.test"
+
+func TestAccNetboxVpnIpsecProposalMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProposal),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIpsecProposal,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIpsecProposalFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProposal),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnIpsecProposal,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnIpsecProposalMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProposal),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProposal),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProposal),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnIpsecProposal),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnIpsecProposalConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnipsecproposal-{{ .namesuffix }}"
+		slug = "vpnipsecproposal-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_ipsec_proposal" "test" {
+		name                 = "vpnipsecproposal-{{ .namesuffix }}"
+		encryption_algorithm = "aes-256-gcm"
+
+		{{ if eq .resourcefull "true" }}
+		authentication_algorithm = "hmac-sha256"
+		comments                 = <<-EOT
+		Comments for Test IPSec proposal
+		Multiline
+		EOT
+		description         = "Test IPSec proposal"
+		sa_lifetime_data    = 4608000
+		sa_lifetime_seconds = 3600
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,146 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnL2vpnTermination = "" +
+	"netbox_vpn_l2vpn_termination.test"
+
+func TestAccNetboxVpnL2vpnTerminationMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpnTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnL2vpnTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnL2vpnTerminationFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpnTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnL2vpnTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnL2vpnTerminationMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpnTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpnTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpnTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpnTermination),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnL2vpnTerminationConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_ipam_vlan" "test" {
+		vlan_id = 100
+		name    = "vpnl2vpnterm-{{ .namesuffix }}"
+	}
+
+	resource "netbox_vpn_l2vpn" "test" {
+		name = "vpnl2vpnterm-{{ .namesuffix }}"
+		slug = "vpnl2vpnterm-{{ .namesuffix }}"
+		type = "vxlan-evpn"
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnl2vpnterm-{{ .namesuffix }}"
+		slug = "vpnl2vpnterm-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_l2vpn_termination" "test" {
+		l2vpn_id             = netbox_vpn_l2vpn.test.id
+		assigned_object_type = "ipam.vlan"
+		assigned_object_id   = netbox_ipam_vlan.test.id
+
+		{{ if eq .resourcefull "true" }}
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,157 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnL2vpn = "netbox_vpn_l2vpn.test"
+
+func TestAccNetboxVpnL2vpnMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnL2vpnConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpn),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnL2vpn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnL2vpnFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnL2vpnConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpn),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnL2vpn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnL2vpnMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnL2vpnConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpn),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnL2vpnConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpn),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnL2vpnConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpn),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnL2vpnConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnL2vpn),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnL2vpnConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpnl2vpn-{{ .namesuffix }}"
+		slug = "vpnl2vpn-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_route_targets" "export" {
+		name = "vpnl2vpn-export-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_route_targets" "import" {
+		name = "vpnl2vpn-import-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "vpnl2vpn-{{ .namesuffix }}"
+		slug = "vpnl2vpn-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_l2vpn" "test" {
+		name = "vpnl2vpn-{{ .namesuffix }}"
+		slug = "vpnl2vpn-{{ .namesuffix }}"
+		type = "vxlan-evpn"
+
+		{{ if eq .resourcefull "true" }}
+		comments = <<-EOT
+		Comments for Test L2VPN
+		Multiline
+		EOT
+		description    = "Test L2VPN"
+		export_targets = [netbox_ipam_route_targets.export.id]
+		identifier     = 10100
+		import_targets = [netbox_ipam_route_targets.import.id]
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,454 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxVpnTunnel() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a tunnel within Netbox.",
+		CreateContext: resourceNetboxVpnTunnelCreate,
+		ReadContext:   resourceNetboxVpnTunnelRead,
+		UpdateContext: resourceNetboxVpnTunnelUpdate,
+		DeleteContext: resourceNetboxVpnTunnelDelete,
+		Exists:        resourceNetboxVpnTunnelExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this tunnel.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this tunnel.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this tunnel was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this tunnel.",
+			},
+			"encapsulation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(netbox.
+						AllowedPatchedWritableTunnelRequestEncapsulationEnumValues),
+					false),
+				Description: "The encapsulation among ipsec-transport, " +
+					"ipsec-tunnel, ip-ip or gre of this tunnel.",
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tunnel group of this tunnel.",
+			},
+			"ipsec_profile_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the IPSec profile of this tunnel.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this tunnel was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const100),
+				Description:  "The name of this tunnel.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedPatchedWritableTunnelRequestStatusEnumValues),
+					false),
+				Description: "The status among planned, active or disabled " +
+					"(active by default) of this tunnel.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this tunnel.",
+			},
+			"terminations_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of terminations of this tunnel.",
+			},
+			"tunnel_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The numeric identifier of this tunnel.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this tunnel.",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnTunnelCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	encapsulation, err := netbox.
+		NewPatchedWritableTunnelRequestEncapsulationFromValue(
+			d.Get("encapsulation").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	newResource := netbox.NewWritableTunnelRequest(d.Get("name").(string),
+		*encapsulation)
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if groupID := d.Get("group_id").(int); groupID != 0 {
+		b, errDiag := brief.GetBriefTunnelGroupRequestFromID(ctx, client,
+			groupID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetGroup(*b)
+	}
+
+	if profileID := d.Get("ipsec_profile_id").(int); profileID != 0 {
+		b, errDiag := brief.GetBriefIPSecProfileRequestFromID(ctx, client,
+			profileID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetIpsecProfile(*b)
+	}
+
+	status, err := netbox.NewPatchedWritableTunnelRequestStatusFromValue(
+		d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetStatus(*status)
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetTenant(*b)
+	}
+
+	if tunnelID, exist := d.GetOk("tunnel_id"); exist {
+		newResource.SetTunnelId(int64(tunnelID.(int)))
+	}
+
+	_, response, err := client.VpnAPI.VpnTunnelsCreate(
+		ctx).WritableTunnelRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxVpnTunnelRead(ctx, d, m)
+}
+
+func resourceNetboxVpnTunnelRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.VpnAPI.VpnTunnelsRetrieve(ctx,
+		int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("encapsulation",
+		resource.GetEncapsulation().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("group_id", resource.GetGroup().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("ipsec_profile_id",
+		resource.GetIpsecProfile().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("name", resource.GetName()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("terminations_count",
+		resource.GetTerminationsCount()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tunnel_id", resource.GetTunnelId()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxVpnTunnelUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableTunnelRequestWithDefaults()
+
+	// Required fields
+	resource.SetName(d.Get("name").(string))
+
+	encapsulation, err := netbox.
+		NewPatchedWritableTunnelRequestEncapsulationFromValue(
+			d.Get("encapsulation").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	resource.SetEncapsulation(*encapsulation)
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("group_id") {
+		if groupID := d.Get("group_id").(int); groupID != 0 {
+			b, errDiag := brief.GetBriefTunnelGroupRequestFromID(ctx, client,
+				groupID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetGroup(*b)
+		} else {
+			resource.SetGroupNil()
+		}
+	}
+
+	if d.HasChange("ipsec_profile_id") {
+		if profileID := d.Get("ipsec_profile_id").(int); profileID != 0 {
+			b, errDiag := brief.GetBriefIPSecProfileRequestFromID(ctx, client,
+				profileID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetIpsecProfile(*b)
+		} else {
+			resource.SetIpsecProfileNil()
+		}
+	}
+
+	if d.HasChange("status") {
+		status, err := netbox.NewPatchedWritableTunnelRequestStatusFromValue(
+			d.Get("status").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetStatus(*status)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client,
+				tenantID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if d.HasChange("tunnel_id") {
+		if tunnelID, exist := d.GetOk("tunnel_id"); exist {
+			resource.SetTunnelId(int64(tunnelID.(int)))
+		} else {
+			resource.SetTunnelIdNil()
+		}
+	}
+
+	if _, response, err := client.VpnAPI.VpnTunnelsUpdate(ctx,
+		int32(resourceID)).WritableTunnelRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxVpnTunnelRead(ctx, d, m)
+}
+
+func resourceNetboxVpnTunnelDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxVpnTunnelExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.VpnAPI.VpnTunnelsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnTunnelExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.VpnAPI.VpnTunnelsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,345 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+var tunnelTerminationTypes = []string{
+	"dcim.interface",
+	"virtualization.vminterface",
+}
+
+func ResourceNetboxVpnTunnelTermination() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a tunnel termination within Netbox.",
+		CreateContext: resourceNetboxVpnTunnelTerminationCreate,
+		ReadContext:   resourceNetboxVpnTunnelTerminationRead,
+		UpdateContext: resourceNetboxVpnTunnelTerminationUpdate,
+		DeleteContext: resourceNetboxVpnTunnelTerminationDelete,
+		Exists:        resourceNetboxVpnTunnelTerminationExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this tunnel termination.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this tunnel termination was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Date when this tunnel termination was last " +
+					"updated.",
+			},
+			"outside_ip_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "ID of the outside IP address of this tunnel " +
+					"termination.",
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "peer",
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(netbox.
+						AllowedPatchedWritableTunnelTerminationRequestRoleEnumValues),
+					false),
+				Description: "The role among peer, hub or spoke (peer by " +
+					"default) of this tunnel termination.",
+			},
+			"tag": &tag.TagSchema,
+			"termination_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the interface terminating this tunnel " +
+					"termination.",
+			},
+			"termination_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					tunnelTerminationTypes, false),
+				Description: "The type of the interface terminating this " +
+					"tunnel termination (dcim.interface or " +
+					"virtualization.vminterface).",
+			},
+			"tunnel_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the tunnel of this tunnel termination.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this tunnel termination.",
+			},
+		},
+	}
+}
+
+func resourceNetboxVpnTunnelTerminationCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	tunnel, errDiag := brief.GetBriefTunnelRequestFromID(ctx, client,
+		d.Get("tunnel_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+
+	terminationID := int64(d.Get("termination_id").(int))
+	newResource := netbox.NewWritableTunnelTerminationRequest(*tunnel,
+		d.Get("termination_type").(string),
+		*netbox.NewNullableInt64(&terminationID))
+	newResource.SetCustomFields(customFields)
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	if outsideIPID := d.Get("outside_ip_id").(int); outsideIPID != 0 {
+		b, errDiag := brief.GetBriefIPAdressRequestFromID(ctx, client,
+			outsideIPID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetOutsideIp(*b)
+	}
+
+	role, err := netbox.NewPatchedWritableTunnelTerminationRequestRoleFromValue(
+		d.Get("role").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetRole(*role)
+
+	_, response, err := client.VpnAPI.VpnTunnelTerminationsCreate(
+		ctx).WritableTunnelTerminationRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxVpnTunnelTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxVpnTunnelTerminationRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.VpnAPI.VpnTunnelTerminationsRetrieve(
+		ctx, int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("outside_ip_id", resource.GetOutsideIp().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("role", resource.GetRole().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("termination_id",
+		resource.GetTerminationId()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("termination_type",
+		resource.GetTerminationType()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tunnel_id", resource.GetTunnel().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnTunnelTerminationUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableTunnelTerminationRequestWithDefaults()
+
+	// Required fields
+	tunnel, errDiag := brief.GetBriefTunnelRequestFromID(ctx, client,
+		d.Get("tunnel_id").(int))
+	if errDiag != nil {
+		return errDiag
+	}
+	resource.SetTunnel(*tunnel)
+	resource.SetTerminationType(d.Get("termination_type").(string))
+	resource.SetTerminationId(int64(d.Get("termination_id").(int)))
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("outside_ip_id") {
+		if outsideIPID := d.Get("outside_ip_id").(int); outsideIPID != 0 {
+			b, errDiag := brief.GetBriefIPAdressRequestFromID(ctx, client,
+				outsideIPID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetOutsideIp(*b)
+		} else {
+			resource.SetOutsideIpNil()
+		}
+	}
+
+	if d.HasChange("role") {
+		role, err := netbox.
+			NewPatchedWritableTunnelTerminationRequestRoleFromValue(
+				d.Get("role").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetRole(*role)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if _, response, err := client.VpnAPI.VpnTunnelTerminationsUpdate(ctx,
+		int32(resourceID)).WritableTunnelTerminationRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxVpnTunnelTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxVpnTunnelTerminationDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxVpnTunnelTerminationExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.VpnAPI.VpnTunnelTerminationsDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxVpnTunnelTerminationExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.VpnAPI.VpnTunnelTerminationsRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
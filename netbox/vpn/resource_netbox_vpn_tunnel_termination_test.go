@@ -0,0 +1,180 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnTunnelTermination = "" +
+	"netbox_vpn_tunnel_termination.test"
+
+func TestAccNetboxVpnTunnelTerminationMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnelTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnTunnelTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnTunnelTerminationFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnelTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnTunnelTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnTunnelTerminationMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnelTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnelTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnelTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnelTermination),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnTunnelTerminationConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "vpntunnelterm-{{ .namesuffix }}"
+		slug = "vpntunnelterm-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "vpntunnelterm-{{ .namesuffix }}"
+		slug            = "vpntunnelterm-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "vpntunnelterm-{{ .namesuffix }}"
+		slug = "vpntunnelterm-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "vpntunnelterm-{{ .namesuffix }}"
+		slug = "vpntunnelterm-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "vpntunnelterm-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "vpntunnelterm-{{ .namesuffix }}"
+		type      = "virtual"
+	}
+
+	resource "netbox_vpn_tunnel" "test" {
+		name          = "vpntunnelterm-{{ .namesuffix }}"
+		encapsulation = "gre"
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpntunnelterm-{{ .namesuffix }}"
+		slug = "vpntunnelterm-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_ip_addresses" "test" {
+		address = "192.0.2.20/32"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_tunnel_termination" "test" {
+		tunnel_id        = netbox_vpn_tunnel.test.id
+		termination_type = "dcim.interface"
+		termination_id   = netbox_dcim_interface.test.id
+
+		{{ if eq .resourcefull "true" }}
+		outside_ip_id = netbox_ipam_ip_addresses.test.id
+		role          = "hub"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,152 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package vpn_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxVpnTunnel = "netbox_vpn_tunnel.test"
+
+func TestAccNetboxVpnTunnelMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnTunnelConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnel),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnTunnel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnTunnelFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnTunnelConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnel),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxVpnTunnel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVpnTunnelMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxVpnTunnelConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnel),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnTunnelConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnel),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnTunnelConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnel),
+				),
+			},
+			{
+				Config: testAccCheckNetboxVpnTunnelConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxVpnTunnel),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxVpnTunnelConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "vpntunnel-{{ .namesuffix }}"
+		slug = "vpntunnel-{{ .namesuffix }}"
+	}
+
+	resource "netbox_vpn_tunnel_group" "test" {
+		name = "vpntunnel-{{ .namesuffix }}"
+		slug = "vpntunnel-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "vpntunnel-{{ .namesuffix }}"
+		slug = "vpntunnel-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_vpn_tunnel" "test" {
+		name          = "vpntunnel-{{ .namesuffix }}"
+		encapsulation = "gre"
+
+		{{ if eq .resourcefull "true" }}
+		comments    = <<-EOT
+		Test tunnel
+		EOT
+		description = "Test tunnel"
+		group_id    = netbox_vpn_tunnel_group.test.id
+		status      = "planned"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		tunnel_id = 10
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
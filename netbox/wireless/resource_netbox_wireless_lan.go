@@ -0,0 +1,466 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package wireless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netbox "github.com/smutel/go-netbox/v4"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/brief"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+func ResourceNetboxWirelessLan() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a wireless LAN within Netbox.",
+		CreateContext: resourceNetboxWirelessLanCreate,
+		ReadContext:   resourceNetboxWirelessLanRead,
+		UpdateContext: resourceNetboxWirelessLanUpdate,
+		DeleteContext: resourceNetboxWirelessLanDelete,
+		Exists:        resourceNetboxWirelessLanExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auth_cipher": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedAuthenticationCipherEnumValues),
+					false),
+				Description: "The authentication cipher among auto, tkip or " +
+					"aes of this wireless LAN.",
+			},
+			"auth_psk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const64),
+				Description:  "The pre-shared key of this wireless LAN.",
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(
+						netbox.AllowedAuthenticationType1EnumValues),
+					false),
+				Description: "The authentication type among open, wep, " +
+					"wpa-personal or wpa-enterprise of this wireless LAN.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this wireless LAN.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this wireless LAN.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this wireless LAN was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, util.Const200),
+				Description:  "The description of this wireless LAN.",
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the group of this wireless LAN.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this wireless LAN was last updated.",
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, util.Const32),
+				Description:  "The SSID of this wireless LAN.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice(
+					util.EnumToListofStrings(netbox.
+						AllowedPatchedWritableWirelessLANRequestStatusEnumValues),
+					false),
+				Description: "The status among active, reserved, disabled or " +
+					"deprecated (active by default) of this wireless LAN.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant of this wireless LAN.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this wireless LAN.",
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the VLAN of this wireless LAN.",
+			},
+		},
+	}
+}
+
+//nolint:gocyclo
+func resourceNetboxWirelessLanCreate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil,
+		resourceCustomFields)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := netbox.NewWritableWirelessLANRequest(d.Get("ssid").(string))
+	newResource.SetAuthPsk(d.Get("auth_psk").(string))
+	newResource.SetComments(d.Get("comments").(string))
+	newResource.SetCustomFields(customFields)
+	newResource.SetDescription(d.Get("description").(string))
+	newResource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+
+	authCipher, err := netbox.NewAuthenticationCipherFromValue(
+		d.Get("auth_cipher").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetAuthCipher(*authCipher)
+
+	authType, err := netbox.NewAuthenticationType1FromValue(
+		d.Get("auth_type").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetAuthType(*authType)
+
+	if groupID := d.Get("group_id").(int); groupID != 0 {
+		b, errDiag := brief.GetBriefWirelessLANGroupRequestFromID(ctx, client,
+			groupID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetGroup(*b)
+	}
+
+	status, err := netbox.NewPatchedWritableWirelessLANRequestStatusFromValue(
+		d.Get("status").(string))
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+	newResource.SetStatus(*status)
+
+	if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+		b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client, tenantID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetTenant(*b)
+	}
+
+	if vlanID := d.Get("vlan_id").(int); vlanID != 0 {
+		b, errDiag := brief.GetBriefVLANRequestFromID(ctx, client, vlanID)
+		if errDiag != nil {
+			return errDiag
+		}
+		newResource.SetVlan(*b)
+	}
+
+	_, response, err := client.WirelessAPI.WirelessWirelessLansCreate(
+		ctx).WritableWirelessLANRequest(*newResource).Execute()
+	if response.StatusCode != util.Const201 && err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	var resourceID int32
+	if resourceID, err = util.UnmarshalID(response.Body); resourceID == 0 {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", resourceID))
+	return resourceNetboxWirelessLanRead(ctx, d, m)
+}
+
+//nolint:gocyclo
+func resourceNetboxWirelessLanRead(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, _ := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	resource, response, err := client.WirelessAPI.WirelessWirelessLansRetrieve(
+		ctx, int32(resourceID)).Execute()
+
+	if response.StatusCode == util.Const404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	if err = d.Set("auth_cipher", resource.GetAuthCipher().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("auth_psk", resource.GetAuthPsk()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("auth_type", resource.GetAuthType().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("comments", resource.GetComments()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("content_type",
+		util.ConvertURLContentType(resource.GetUrl())); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("created", resource.GetCreated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(
+		resourceCustomFields, resource.GetCustomFields())
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("description", resource.GetDescription()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("group_id", resource.GetGroup().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("last_updated",
+		resource.GetLastUpdated().String()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("ssid", resource.GetSsid()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("status", resource.GetStatus().Value); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tag",
+		tag.ConvertNestedTagRequestToTags(resource.Tags)); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("tenant_id", resource.GetTenant().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("url", resource.GetUrl()); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if err = d.Set("vlan_id", resource.GetVlan().Id); err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func resourceNetboxWirelessLanUpdate(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+	resource := netbox.NewWritableWirelessLANRequestWithDefaults()
+
+	// Required fields
+	resource.SetSsid(d.Get("ssid").(string))
+
+	if d.HasChange("auth_cipher") {
+		authCipher, err := netbox.NewAuthenticationCipherFromValue(
+			d.Get("auth_cipher").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAuthCipher(*authCipher)
+	}
+
+	if d.HasChange("auth_psk") {
+		resource.SetAuthPsk(d.Get("auth_psk").(string))
+	}
+
+	if d.HasChange("auth_type") {
+		authType, err := netbox.NewAuthenticationType1FromValue(
+			d.Get("auth_type").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetAuthType(*authType)
+	}
+
+	if d.HasChange("comments") {
+		resource.SetComments(d.Get("comments").(string))
+	}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(
+			stateCustomFields.(*schema.Set).List(),
+			resourceCustomFields.(*schema.Set).List())
+		resource.SetCustomFields(customFields)
+	}
+
+	if d.HasChange("description") {
+		resource.SetDescription(d.Get("description").(string))
+	}
+
+	if d.HasChange("group_id") {
+		if groupID := d.Get("group_id").(int); groupID != 0 {
+			b, errDiag := brief.GetBriefWirelessLANGroupRequestFromID(ctx,
+				client, groupID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetGroup(*b)
+		} else {
+			resource.SetGroupNil()
+		}
+	}
+
+	if d.HasChange("status") {
+		status, err := netbox.
+			NewPatchedWritableWirelessLANRequestStatusFromValue(
+				d.Get("status").(string))
+		if err != nil {
+			return util.GenerateErrorMessage(nil, err)
+		}
+		resource.SetStatus(*status)
+	}
+
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		resource.SetTags(tag.ConvertTagsToNestedTagRequest(tags))
+	}
+
+	if d.HasChange("tenant_id") {
+		if tenantID := d.Get("tenant_id").(int); tenantID != 0 {
+			b, errDiag := brief.GetBriefTenantRequestFromID(ctx, client,
+				tenantID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetTenant(*b)
+		} else {
+			resource.SetTenantNil()
+		}
+	}
+
+	if d.HasChange("vlan_id") {
+		if vlanID := d.Get("vlan_id").(int); vlanID != 0 {
+			b, errDiag := brief.GetBriefVLANRequestFromID(ctx, client, vlanID)
+			if errDiag != nil {
+				return errDiag
+			}
+			resource.SetVlan(*b)
+		} else {
+			resource.SetVlanNil()
+		}
+	}
+
+	if _, response, err := client.WirelessAPI.WirelessWirelessLansUpdate(ctx,
+		int32(resourceID)).WritableWirelessLANRequest(
+		*resource).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return resourceNetboxWirelessLanRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLanDelete(ctx context.Context,
+	d *schema.ResourceData, m any) diag.Diagnostics {
+
+	client := m.(*netbox.APIClient)
+
+	resourceExists, err := resourceNetboxWirelessLanExists(d, m)
+	if err != nil {
+		return util.GenerateErrorMessage(nil, err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return util.GenerateErrorMessage(nil,
+			errors.New("Unable to convert ID into int64"))
+	}
+
+	if response, err := client.WirelessAPI.WirelessWirelessLansDestroy(ctx,
+		int32(resourceID)).Execute(); err != nil {
+		return util.GenerateErrorMessage(response, err)
+	}
+
+	return nil
+}
+
+func resourceNetboxWirelessLanExists(d *schema.ResourceData,
+	m any) (b bool, e error) {
+	client := m.(*netbox.APIClient)
+
+	resourceID, err := strconv.ParseInt(d.Id(), util.Const10, util.Const32)
+	if err != nil {
+		return false, err
+	}
+
+	_, http, err := client.WirelessAPI.WirelessWirelessLansRetrieve(nil,
+		int32(resourceID)).Execute()
+	if err != nil && http.StatusCode == util.Const404 {
+		return false, nil
+	} else if err == nil && http.StatusCode == util.Const200 {
+		return true, nil
+	}
+
+	return false, err
+}
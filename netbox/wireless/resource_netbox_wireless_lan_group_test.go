@@ -0,0 +1,141 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package wireless_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxWirelessLanGroup = "netbox_wireless_lan_group.test"
+
+func TestAccNetboxWirelessLanGroupMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLanGroupConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLanGroup),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxWirelessLanGroup,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxWirelessLanGroupFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLanGroupConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLanGroup),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxWirelessLanGroup,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxWirelessLanGroupMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLanGroupConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLanGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLanGroupConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLanGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLanGroupConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLanGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLanGroupConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLanGroup),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxWirelessLanGroupConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "wlangroup-{{ .namesuffix }}"
+		slug = "wlangroup-{{ .namesuffix }}"
+	}
+
+	resource "netbox_wireless_lan_group" "parent" {
+		name = "wlangroup-parent-{{ .namesuffix }}"
+		slug = "wlangroup-parent-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_wireless_lan_group" "test" {
+		name = "wlangroup-{{ .namesuffix }}"
+		slug = "wlangroup-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		description = "Test wireless LAN group"
+		parent_id   = netbox_wireless_lan_group.parent.id
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,159 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package wireless_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxWirelessLan = "netbox_wireless_lan.test"
+
+func TestAccNetboxWirelessLanMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLanConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLan),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxWirelessLan,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxWirelessLanFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLanConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLan),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxWirelessLan,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxWirelessLanMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLanConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLan),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLanConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLan),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLanConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLan),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLanConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLan),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxWirelessLanConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "wlan-{{ .namesuffix }}"
+		slug = "wlan-{{ .namesuffix }}"
+	}
+
+	resource "netbox_wireless_lan_group" "test" {
+		name = "wlan-{{ .namesuffix }}"
+		slug = "wlan-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_vlan" "test" {
+		vlan_id = 100
+		name    = "wlan-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "wlan-{{ .namesuffix }}"
+		slug = "wlan-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_wireless_lan" "test" {
+		ssid = "wlan-{{ .namesuffix }}"
+
+		{{ if eq .resourcefull "true" }}
+		auth_cipher = "aes"
+		auth_psk    = "wlan-psk-{{ .namesuffix }}"
+		auth_type   = "wpa-personal"
+		comments    = <<-EOT
+		Test wireless LAN
+		EOT
+		description = "Test wireless LAN"
+		group_id    = netbox_wireless_lan_group.test.id
+		status      = "reserved"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		vlan_id   = netbox_ipam_vlan.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
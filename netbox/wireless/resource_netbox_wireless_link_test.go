@@ -0,0 +1,188 @@
+// Copyright (c)
+// SPDX-License-Identifier: MIT
+
+package wireless_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v8/netbox/internal/util"
+)
+
+const resourceNameNetboxWirelessLink = "netbox_wireless_link.test"
+
+func TestAccNetboxWirelessLinkMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLinkConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLink),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxWirelessLink,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxWirelessLinkFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLinkConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLink),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxWirelessLink,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxWirelessLinkMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(util.Const10,
+		acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxWirelessLinkConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLink),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLinkConfig(nameSuffix,
+					true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLink),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLinkConfig(nameSuffix,
+					false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLink),
+				),
+			},
+			{
+				Config: testAccCheckNetboxWirelessLinkConfig(nameSuffix,
+					false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxWirelessLink),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxWirelessLinkConfig(nameSuffix string,
+	resourceFull, extraResources bool) string {
+
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "wlink-{{ .namesuffix }}"
+		slug = "wlink-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "wlink-{{ .namesuffix }}"
+		slug            = "wlink-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "wlink-{{ .namesuffix }}"
+		slug = "wlink-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "wlink-{{ .namesuffix }}"
+		slug = "wlink-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "wlink-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "test_a" {
+		device_id = netbox_dcim_device.test.id
+		name      = "wlan0"
+		type      = "ieee802.11ac"
+	}
+
+	resource "netbox_dcim_interface" "test_b" {
+		device_id = netbox_dcim_device.test.id
+		name      = "wlan1"
+		type      = "ieee802.11ac"
+	}
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "wlink-{{ .namesuffix }}"
+		slug = "wlink-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "wlink-{{ .namesuffix }}"
+		slug = "wlink-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_wireless_link" "test" {
+		interface_a_id = netbox_dcim_interface.test_a.id
+		interface_b_id = netbox_dcim_interface.test_b.id
+
+		{{ if eq .resourcefull "true" }}
+		auth_cipher = "aes"
+		auth_psk    = "wlink-psk-{{ .namesuffix }}"
+		auth_type   = "wpa-personal"
+		comments    = <<-EOT
+		Test wireless link
+		EOT
+		description = "Test wireless link"
+		ssid        = "wlink-{{ .namesuffix }}"
+		status      = "planned"
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		tenant_id = netbox_tenancy_tenant.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}